@@ -0,0 +1,286 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "net/url"
+    "strconv"
+    "time"
+)
+
+const (
+    ADS_API_VERSION       = "1"
+    ADS_PRODUCT_ID        = "PADS"
+    ADS_DATE_LAYOUT       = "2006-01-02"
+    ADS_MAX_DAYS          = 90
+    ADS_AGGREGATION_DAILY = "DAILY"
+
+    //Metrics requested to the advertising API.
+    adsMetrics = "clicks,prints,ctr,cost,cpc,acos,direct_amount,indirect_amount,total_amount,direct_units_quantity,indirect_units_quantity,units_quantity"
+)
+
+type Advertiser struct {
+    AdvertiserId   int64  `json:"advertiser_id"`
+    SiteId         string `json:"site_id"`
+    AdvertiserName string `json:"advertiser_name"`
+    AccountName    string `json:"account_name"`
+}
+
+/*
+AdMetrics are the metrics of an advertiser, campaign or ad in a date range. Acos is the cost over the sales,
+as a percentage.
+*/
+type AdMetrics struct {
+    Clicks                int     `json:"clicks"`
+    Prints                int     `json:"prints"`
+    Ctr                   float64 `json:"ctr"`
+    Cost                  Money   `json:"cost"`
+    Cpc                   Money   `json:"cpc"`
+    Acos                  float64 `json:"acos"`
+    DirectAmount          Money   `json:"direct_amount"`
+    IndirectAmount        Money   `json:"indirect_amount"`
+    TotalAmount           Money   `json:"total_amount"`
+    DirectUnitsQuantity   int     `json:"direct_units_quantity"`
+    IndirectUnitsQuantity int     `json:"indirect_units_quantity"`
+    UnitsQuantity         int     `json:"units_quantity"`
+}
+
+//Adds the metrics of other, recomputing the rates.
+func (m *AdMetrics) Add(other AdMetrics) {
+
+    m.Clicks += other.Clicks
+    m.Prints += other.Prints
+    m.Cost += other.Cost
+    m.DirectAmount += other.DirectAmount
+    m.IndirectAmount += other.IndirectAmount
+    m.TotalAmount += other.TotalAmount
+    m.DirectUnitsQuantity += other.DirectUnitsQuantity
+    m.IndirectUnitsQuantity += other.IndirectUnitsQuantity
+    m.UnitsQuantity += other.UnitsQuantity
+
+    m.Ctr, m.Cpc, m.Acos = 0, 0, 0
+
+    if m.Prints > 0 {
+        m.Ctr = 100 * float64(m.Clicks) / float64(m.Prints)
+    }
+
+    if m.Clicks > 0 {
+        m.Cpc = (m.Cost / Money(m.Clicks)).Round(2)
+    }
+
+    if m.TotalAmount > 0 {
+        m.Acos = 100 * float64(m.Cost) / float64(m.TotalAmount)
+    }
+}
+
+type Campaign struct {
+    Id          int64     `json:"id"`
+    Name        string    `json:"name"`
+    Status      string    `json:"status"`
+    Budget      Money     `json:"budget"`
+    Strategy    string    `json:"strategy"`
+    AcosTarget  float64   `json:"acos_target"`
+    DateCreated string    `json:"date_created"`
+    Metrics     AdMetrics `json:"metrics"`
+}
+
+type Ad struct {
+    ItemId     string    `json:"item_id"`
+    CampaignId int64     `json:"campaign_id"`
+    Title      string    `json:"title"`
+    Status     string    `json:"status"`
+    Price      Money     `json:"price"`
+    Metrics    AdMetrics `json:"metrics"`
+}
+
+//Page decoded by the Pagers of ProductAds.Campaigns.
+type CampaignsPage struct {
+    Paging  Paging     `json:"paging"`
+    Results []Campaign `json:"results"`
+}
+
+//Page decoded by the Pagers of ProductAds.Ads.
+type AdsPage struct {
+    Paging  Paging `json:"paging"`
+    Results []Ad   `json:"results"`
+}
+
+/*
+CampaignReport has the metrics of a campaign in a date range: the summary and, when aggregated daily, one
+entry per day.
+*/
+type CampaignReport struct {
+    Campaign
+    MetricsSummary AdMetrics `json:"metrics_summary"`
+    Results        []struct {
+        Date string `json:"date"`
+        AdMetrics
+    } `json:"results"`
+}
+
+/*
+DateRange limits the metrics to the days between From and To, both included. The advertising API keeps the last
+ADS_MAX_DAYS days.
+*/
+type DateRange struct {
+    From time.Time
+    To   time.Time
+}
+
+func (d DateRange) check() error {
+
+    if d.To.Before(d.From) {
+        return errors.New("The date range finishes before it starts.")
+    }
+
+    if time.Since(d.From) > ADS_MAX_DAYS*24*time.Hour {
+        return fmt.Errorf("Metrics are only kept for the last %d days.", ADS_MAX_DAYS)
+    }
+
+    return nil
+}
+
+func (d DateRange) params() url.Values {
+
+    params := url.Values{}
+    params.Set("date_from", d.From.Format(ADS_DATE_LAYOUT))
+    params.Set("date_to", d.To.Format(ADS_DATE_LAYOUT))
+    params.Set("metrics", adsMetrics)
+
+    return params
+}
+
+/*
+ProductAds gives typed access to the metrics of the Product Ads campaigns. It uses the token of the client,
+which has to belong to the advertiser.
+*/
+type ProductAds struct {
+    client *Client
+}
+
+func (client *Client) ProductAds() *ProductAds {
+    return &ProductAds{client: client}
+}
+
+func advertiserPath(advertiserId int64) string {
+    return "/advertising/advertisers/" + strconv.FormatInt(advertiserId, 10) + "/product_ads"
+}
+
+func (a *ProductAds) Advertisers() ([]Advertiser, error) {
+
+    var advertisers struct {
+        Advertisers []Advertiser `json:"advertisers"`
+    }
+
+    err := a.client.NewRequest(http.MethodGet, "/advertising/advertisers").
+        Query("product_id", ADS_PRODUCT_ID).
+        Header("Api-Version", ADS_API_VERSION).
+        Decode(&advertisers)
+
+    return advertisers.Advertisers, err
+}
+
+/*
+This method returns a Pager over the campaigns of the advertiser with their metrics in the date range.
+Each page is decoded into a CampaignsPage.
+*/
+func (a *ProductAds) Campaigns(advertiserId int64, dates DateRange, limit int) (*Pager, error) {
+
+    if err := dates.check(); err != nil {
+        return nil, err
+    }
+
+    return a.client.NewPager(advertiserPath(advertiserId)+"/campaigns", dates.params(), limit).Header("Api-Version", ADS_API_VERSION), nil
+}
+
+/*
+This method returns the metrics of the campaign in the date range. aggregation may be ADS_AGGREGATION_DAILY for
+getting them day by day, or empty for the summary only.
+*/
+func (a *ProductAds) CampaignMetrics(campaignId int64, dates DateRange, aggregation string) (*CampaignReport, error) {
+
+    if err := dates.check(); err != nil {
+        return nil, err
+    }
+
+    params := dates.params()
+    if aggregation != "" {
+        params.Set("aggregation_type", aggregation)
+    }
+
+    report := new(CampaignReport)
+
+    err := a.client.NewRequest(http.MethodGet, "/advertising/product_ads/campaigns/"+strconv.FormatInt(campaignId, 10)).
+        Params(params).
+        Header("Api-Version", ADS_API_VERSION).
+        Decode(report)
+
+    if err != nil {
+        return nil, err
+    }
+
+    return report, nil
+}
+
+/*
+This method returns a Pager over the ads of the advertiser with their metrics in the date range.
+Each page is decoded into an AdsPage.
+*/
+func (a *ProductAds) Ads(advertiserId int64, dates DateRange, limit int) (*Pager, error) {
+
+    if err := dates.check(); err != nil {
+        return nil, err
+    }
+
+    return a.client.NewPager(advertiserPath(advertiserId)+"/ads/search", dates.params(), limit).Header("Api-Version", ADS_API_VERSION), nil
+}
+
+/*
+This method streams every ad of the advertiser to fn, a page at a time, so large reports are not kept in memory.
+It stops at the first error returned by fn.
+*/
+func (a *ProductAds) EachAd(advertiserId int64, dates DateRange, fn func(ad Ad) error) error {
+
+    pager, err := a.Ads(advertiserId, dates, 0)
+
+    if err != nil {
+        return err
+    }
+
+    for pager.HasNext() {
+        var page AdsPage
+
+        if err := pager.Next(&page); err != nil {
+            return err
+        }
+
+        if len(page.Results) == 0 {
+            break
+        }
+
+        for _, ad := range page.Results {
+            if err := fn(ad); err != nil {
+                return err
+            }
+        }
+    }
+
+    return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "fmt"
+    "strconv"
+    "time"
+)
+
+func Test_ads_are_streamed_page_by_page_and_aggregated(t *testing.T) {
+
+    pages := 0
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        query := r.URL.Query()
+        if r.URL.Path != "/advertising/advertisers/7/product_ads/ads/search" || r.Header.Get("Api-Version") != ADS_API_VERSION || query.Get("metrics") == "" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        pages++
+        offset, _ := strconv.Atoi(query.Get("offset"))
+        fmt.Fprintf(w, "{\"paging\":{\"total\":120,\"offset\":%d,\"limit\":50},\"results\":[{\"item_id\":\"MLA%d\",\"metrics\":"+
+            "{\"clicks\":10,\"prints\":1000,\"cost\":25.5,\"total_amount\":100}}]}", offset, offset)
+    })
+    defer server.Close()
+
+    dates := DateRange{From: time.Now().AddDate(0, 0, -30), To: time.Now()}
+    var total AdMetrics
+    var items []string
+
+    err := client.ProductAds().EachAd(7, dates, func(ad Ad) error {
+        items = append(items, ad.ItemId)
+        total.Add(ad.Metrics)
+        return nil
+    })
+
+    if err != nil || pages != 3 || len(items) != 3 || items[2] != "MLA100" {
+        log.Printf("unexpected ads %v %d %v\n", items, pages, err)
+        t.FailNow()
+    }
+
+    if total.Clicks != 30 || total.Cost.String() != "76.5" || total.Cpc.String() != "2.55" || total.Ctr != 1 || total.Acos != 25.5 {
+        log.Printf("unexpected metrics %+v\n", total)
+        t.FailNow()
+    }
+}
+
+func Test_campaign_metrics_are_aggregated_daily(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path != "/advertising/product_ads/campaigns/3" || r.URL.Query().Get("aggregation_type") != ADS_AGGREGATION_DAILY {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        w.Write([]byte("{\"id\":3,\"name\":\"Sunglasses\",\"metrics_summary\":{\"clicks\":5},\"results\":[{\"date\":\"2016-06-01\",\"clicks\":2},{\"date\":\"2016-06-02\",\"clicks\":3}]}"))
+    })
+    defer server.Close()
+
+    report, err := client.ProductAds().CampaignMetrics(3, DateRange{From: time.Now().AddDate(0, 0, -2), To: time.Now()}, ADS_AGGREGATION_DAILY)
+
+    if err != nil || report.Name != "Sunglasses" || report.MetricsSummary.Clicks != 5 || len(report.Results) != 2 || report.Results[1].Clicks != 3 {
+        log.Printf("unexpected report %+v %v\n", report, err)
+        t.FailNow()
+    }
+
+    if _, err := client.ProductAds().CampaignMetrics(3, DateRange{From: time.Now().AddDate(0, 0, -ADS_MAX_DAYS-1), To: time.Now()}, ""); err == nil {
+        log.Printf("ranges older than the kept days should fail\n")
+        t.FailNow()
+    }
+}
@@ -0,0 +1,253 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "encoding/csv"
+    "fmt"
+    "io"
+    "net/http"
+    "strconv"
+    "strings"
+    "time"
+)
+
+const (
+    VISITS_UNIT_DAY  = "day"
+    VISITS_UNIT_HOUR = "hour"
+
+    //Max amount of ids accepted by the visits of many items.
+    MAX_VISITS_IDS_PER_REQUEST = 50
+)
+
+/*
+VisitsSeries has the visits of an item along the last days or hours.
+*/
+type VisitsSeries struct {
+    ItemId      string        `json:"item_id"`
+    TotalVisits int           `json:"total_visits"`
+    DateFrom    string        `json:"date_from"`
+    DateTo      string        `json:"date_to"`
+    Last        int           `json:"last"`
+    Unit        string        `json:"unit"`
+    Results     []VisitsPoint `json:"results"`
+}
+
+type VisitsPoint struct {
+    Date  string `json:"date"`
+    Total int    `json:"total"`
+}
+
+/*
+ItemPerformance joins the visits of an item with its sales in the same period. ConversionRate is the share of
+visits which ended in an order.
+*/
+type ItemPerformance struct {
+    ItemId         string
+    Visits         int
+    Orders         int
+    Units          int
+    ConversionRate float64
+}
+
+/*
+Analytics gives typed access to the visits of the items and their performance.
+*/
+type Analytics struct {
+    client *Client
+}
+
+func (client *Client) Analytics() *Analytics {
+    return &Analytics{client: client}
+}
+
+/*
+This method returns the total visits of each item, MAX_VISITS_IDS_PER_REQUEST items per call.
+*/
+func (a *Analytics) Visits(itemIds []string) (map[string]int, error) {
+
+    visits := map[string]int{}
+
+    for start := 0; start < len(itemIds); start += MAX_VISITS_IDS_PER_REQUEST {
+
+        end := start + MAX_VISITS_IDS_PER_REQUEST
+        if end > len(itemIds) {
+            end = len(itemIds)
+        }
+
+        var page map[string]int
+
+        if err := a.client.NewRequest(http.MethodGet, "/visits/items").Query("ids", strings.Join(itemIds[start:end], ",")).Decode(&page); err != nil {
+            return nil, err
+        }
+
+        for id, total := range page {
+            visits[id] = total
+        }
+    }
+
+    return visits, nil
+}
+
+//Same as Visits, but only counts the visits between from and to.
+func (a *Analytics) VisitsBetween(itemIds []string, from time.Time, to time.Time) (map[string]int, error) {
+
+    visits := map[string]int{}
+
+    for start := 0; start < len(itemIds); start += MAX_VISITS_IDS_PER_REQUEST {
+
+        end := start + MAX_VISITS_IDS_PER_REQUEST
+        if end > len(itemIds) {
+            end = len(itemIds)
+        }
+
+        var page []struct {
+            ItemId      string `json:"item_id"`
+            TotalVisits int    `json:"total_visits"`
+        }
+
+        err := a.client.NewRequest(http.MethodGet, "/items/visits").
+            Query("ids", strings.Join(itemIds[start:end], ",")).
+            Query("date_from", from.Format(ORDER_DATE_LAYOUT)).
+            Query("date_to", to.Format(ORDER_DATE_LAYOUT)).
+            Decode(&page)
+
+        if err != nil {
+            return nil, err
+        }
+
+        for _, item := range page {
+            visits[item.ItemId] = item.TotalVisits
+        }
+    }
+
+    return visits, nil
+}
+
+/*
+This method returns the visits of the item along the last days or hours; unit is VISITS_UNIT_DAY or VISITS_UNIT_HOUR.
+*/
+func (a *Analytics) TimeSeries(itemId string, last int, unit string) (*VisitsSeries, error) {
+
+    if unit != VISITS_UNIT_DAY && unit != VISITS_UNIT_HOUR {
+        return nil, fmt.Errorf("Unknown unit %s.", unit)
+    }
+
+    series := new(VisitsSeries)
+
+    err := a.client.NewRequest(http.MethodGet, "/items/"+itemId+"/visits/time_window").
+        Query("last", strconv.Itoa(last)).
+        Query("unit", unit).
+        Decode(series)
+
+    if err != nil {
+        return nil, err
+    }
+
+    return series, nil
+}
+
+/*
+This method joins the visits of the items between from and to with the orders the seller received for them in
+the same period. Cancelled orders are not counted, and an order is counted once per item even when the item is
+in several of its order items. Repeated ids are returned once.
+*/
+func (a *Analytics) Performance(sellerId int64, itemIds []string, from time.Time, to time.Time) ([]ItemPerformance, error) {
+
+    index := map[string]int{}
+    var unique []string
+
+    for _, id := range itemIds {
+        if _, ok := index[id]; !ok {
+            index[id] = len(unique)
+            unique = append(unique, id)
+        }
+    }
+
+    visits, err := a.VisitsBetween(unique, from, to)
+
+    if err != nil {
+        return nil, err
+    }
+
+    orders, err := a.client.Orders().Between(sellerId, from, to)
+
+    if err != nil {
+        return nil, err
+    }
+
+    performances := make([]ItemPerformance, len(unique))
+
+    for i, id := range unique {
+        performances[i] = ItemPerformance{ItemId: id, Visits: visits[id]}
+    }
+
+    for _, order := range orders {
+
+        if order.Status == ORDER_CANCELLED {
+            continue
+        }
+
+        counted := map[int]bool{}
+
+        for _, orderItem := range order.OrderItems {
+            if i, ok := index[orderItem.Item.Id]; ok {
+                if !counted[i] {
+                    counted[i] = true
+                    performances[i].Orders++
+                }
+                performances[i].Units += orderItem.Quantity
+            }
+        }
+    }
+
+    for i := range performances {
+        if performances[i].Visits > 0 {
+            performances[i].ConversionRate = float64(performances[i].Orders) / float64(performances[i].Visits)
+        }
+    }
+
+    return performances, nil
+}
+
+//Writes the visits of the series as CSV, one row per day or hour.
+func (s *VisitsSeries) WriteCSV(w io.Writer) error {
+
+    writer := csv.NewWriter(w)
+    writer.Write([]string{"item_id", "date", "visits"})
+
+    for _, point := range s.Results {
+        writer.Write([]string{s.ItemId, point.Date, strconv.Itoa(point.Total)})
+    }
+
+    writer.Flush()
+    return writer.Error()
+}
+
+//Writes the performance of the items as CSV, one row per item.
+func WritePerformanceCSV(w io.Writer, performances []ItemPerformance) error {
+
+    writer := csv.NewWriter(w)
+    writer.Write([]string{"item_id", "visits", "orders", "units", "conversion_rate"})
+
+    for _, p := range performances {
+        writer.Write([]string{p.ItemId, strconv.Itoa(p.Visits), strconv.Itoa(p.Orders), strconv.Itoa(p.Units), strconv.FormatFloat(p.ConversionRate, 'f', 4, 64)})
+    }
+
+    writer.Flush()
+    return writer.Error()
+}
@@ -0,0 +1,121 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "bytes"
+    "strings"
+    "time"
+    "fmt"
+)
+
+func Test_visits_of_many_items_are_fetched_in_chunks(t *testing.T) {
+
+    requests := 0
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        requests++
+        ids := strings.Split(r.URL.Query().Get("ids"), ",")
+        if r.URL.Path != "/visits/items" || len(ids) > MAX_VISITS_IDS_PER_REQUEST {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        visits := make([]string, 0, len(ids))
+        for _, id := range ids {
+            visits = append(visits, fmt.Sprintf("\"%s\":%d", id, len(id)))
+        }
+        w.Write([]byte("{" + strings.Join(visits, ",") + "}"))
+    })
+    defer server.Close()
+
+    ids := make([]string, 0, 60)
+    for i := 0; i < 60; i++ {
+        ids = append(ids, fmt.Sprintf("MLA%d", i))
+    }
+
+    visits, err := client.Analytics().Visits(ids)
+
+    if err != nil || requests != 2 || len(visits) != 60 || visits["MLA10"] != 5 {
+        log.Printf("unexpected visits %v %d %v\n", visits, requests, err)
+        t.FailNow()
+    }
+}
+
+func Test_performance_joins_visits_with_orders_and_is_exported_as_csv(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/items/visits":
+            w.Write([]byte("[{\"item_id\":\"MLA1\",\"total_visits\":200},{\"item_id\":\"MLA2\",\"total_visits\":0}]"))
+        case "/orders/search":
+            w.Write([]byte("{\"paging\":{\"total\":3,\"offset\":0,\"limit\":50},\"results\":[" +
+                "{\"id\":1,\"status\":\"paid\",\"order_items\":[{\"item\":{\"id\":\"MLA1\"},\"quantity\":2}]}," +
+                "{\"id\":2,\"status\":\"paid\",\"order_items\":[{\"item\":{\"id\":\"MLA1\"},\"quantity\":1},{\"item\":{\"id\":\"MLA1\"},\"quantity\":1}]}," +
+                "{\"id\":3,\"status\":\"cancelled\",\"order_items\":[{\"item\":{\"id\":\"MLA1\"},\"quantity\":1}]}]}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    performances, err := client.Analytics().Performance(2, []string{"MLA1", "MLA2", "MLA1"}, time.Now().AddDate(0, 0, -30), time.Now())
+
+    if err != nil || len(performances) != 2 || performances[0].Orders != 2 || performances[0].Units != 4 || performances[0].ConversionRate != 0.01 {
+        log.Printf("unexpected performance %v %v\n", performances, err)
+        t.FailNow()
+    }
+
+    var out bytes.Buffer
+
+    if err := WritePerformanceCSV(&out, performances); err != nil || out.String() != "item_id,visits,orders,units,conversion_rate\nMLA1,200,2,4,0.0100\nMLA2,0,0,0,0.0000\n" {
+        log.Printf("unexpected csv %s %v\n", out.String(), err)
+        t.FailNow()
+    }
+}
+
+func Test_visits_time_series_is_exported_as_csv(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path != "/items/MLA1/visits/time_window" || r.URL.Query().Get("unit") != "day" || r.URL.Query().Get("last") != "2" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        w.Write([]byte("{\"item_id\":\"MLA1\",\"total_visits\":7,\"results\":[{\"date\":\"2016-06-01T00:00:00Z\",\"total\":3},{\"date\":\"2016-06-02T00:00:00Z\",\"total\":4}]}"))
+    })
+    defer server.Close()
+
+    series, err := client.Analytics().TimeSeries("MLA1", 2, VISITS_UNIT_DAY)
+
+    if err != nil || series.TotalVisits != 7 {
+        log.Printf("unexpected series %v %v\n", series, err)
+        t.FailNow()
+    }
+
+    var out bytes.Buffer
+
+    if err := series.WriteCSV(&out); err != nil || out.String() != "item_id,date,visits\nMLA1,2016-06-01T00:00:00Z,3\nMLA1,2016-06-02T00:00:00Z,4\n" {
+        log.Printf("unexpected csv %s %v\n", out.String(), err)
+        t.FailNow()
+    }
+
+    if _, err := client.Analytics().TimeSeries("MLA1", 2, "week"); err == nil {
+        log.Printf("unknown units should fail\n")
+        t.FailNow()
+    }
+}
@@ -0,0 +1,207 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "io"
+    "net/http"
+    "net/url"
+)
+
+const (
+    //Billing groups: charges of Mercado Libre and of Mercado Pago.
+    BILLING_GROUP_ML = "ML"
+    BILLING_GROUP_MP = "MP"
+
+    BILLING_DOCUMENT_BILL        = "BILL"
+    BILLING_DOCUMENT_CREDIT_NOTE = "CREDIT_NOTE"
+
+    billingPath = "/billing/integration"
+)
+
+/*
+BillingPeriod is a monthly billing period. Key identifies it in the rest of the billing APIs.
+*/
+type BillingPeriod struct {
+    Key          string `json:"key"`
+    Amount       Money  `json:"amount"`
+    UnpaidAmount Money  `json:"unpaid_amount"`
+    Period       struct {
+        DateFrom string `json:"date_from"`
+        DateTo   string `json:"date_to"`
+    } `json:"period"`
+    ExpirationDate     string `json:"expiration_date"`
+    DebtExpirationDate string `json:"debt_expiration_date"`
+}
+
+type BillingCharge struct {
+    DetailId     int64  `json:"detail_id"`
+    Description  string `json:"transaction_detail"`
+    Amount       Money  `json:"detail_amount"`
+    Type         string `json:"detail_type"`
+    SubType      string `json:"detail_sub_type"`
+    CreationDate string `json:"creation_date_time"`
+    DocumentId   int64  `json:"document_id"`
+    CurrencyId   string `json:"currency_id"`
+}
+
+/*
+BillingDetail is a charge of a period, together with the sales it comes from, if any.
+*/
+type BillingDetail struct {
+    ChargeInfo BillingCharge `json:"charge_info"`
+    SalesInfo  []struct {
+        OrderId       int64  `json:"order_id"`
+        OperationId   int64  `json:"operation_id"`
+        SaleDateTime  string `json:"sale_date_time"`
+        SalesChannel  string `json:"sales_channel"`
+        PayerNickname string `json:"payer_nickname"`
+    } `json:"sales_info"`
+}
+
+//Page decoded by the Pagers of Billing.Periods.
+type BillingPeriods struct {
+    Results []BillingPeriod `json:"results"`
+}
+
+//Page decoded by the Pagers of Billing.Details.
+type BillingDetails struct {
+    Results []BillingDetail `json:"results"`
+}
+
+type BillingDocument struct {
+    Id           int64         `json:"id"`
+    DocumentType string        `json:"document_type"`
+    Amount       Money         `json:"amount"`
+    Files        []BillingFile `json:"files"`
+}
+
+type BillingFile struct {
+    FileId          string `json:"file_id"`
+    ReferenceNumber string `json:"reference_number"`
+}
+
+/*
+Billing gives typed access to the billing periods, their charges and invoices.
+*/
+type Billing struct {
+    client *Client
+}
+
+func (client *Client) Billing() *Billing {
+    return &Billing{client: client}
+}
+
+/*
+This method returns a Pager over the monthly periods of the group (BILLING_GROUP_ML or BILLING_GROUP_MP), from the
+newest to the oldest one. Each page is decoded into BillingPeriods.
+*/
+func (b *Billing) Periods(group string, limit int) *Pager {
+
+    params := url.Values{}
+    params.Set("group", group)
+    params.Set("document_type", BILLING_DOCUMENT_BILL)
+
+    return b.client.NewPager(billingPath+"/monthly/periods", params, limit)
+}
+
+/*
+This method returns a Pager over the charges of the period. Each page is decoded into BillingDetails.
+*/
+func (b *Billing) Details(group string, periodKey string, limit int) *Pager {
+
+    params := url.Values{}
+    params.Set("document_type", BILLING_DOCUMENT_BILL)
+
+    return b.client.NewPager(billingPath+"/periods/key/"+periodKey+"/group/"+group+"/details", params, limit)
+}
+
+//Reads every charge of the period.
+func (b *Billing) Charges(group string, periodKey string) ([]BillingCharge, error) {
+
+    pager := b.Details(group, periodKey, 0)
+    var charges []BillingCharge
+
+    for pager.HasNext() {
+        var page BillingDetails
+
+        if err := pager.Next(&page); err != nil {
+            return nil, err
+        }
+
+        if len(page.Results) == 0 {
+            break
+        }
+
+        for _, detail := range page.Results {
+            charges = append(charges, detail.ChargeInfo)
+        }
+    }
+
+    return charges, nil
+}
+
+//Returns the invoices and credit notes of the period.
+func (b *Billing) Documents(group string, periodKey string) ([]BillingDocument, error) {
+
+    var documents struct {
+        Results []BillingDocument `json:"results"`
+    }
+
+    err := b.client.NewRequest(http.MethodGet, billingPath+"/periods/key/"+periodKey+"/documents").
+        Query("group", group).
+        Decode(&documents)
+
+    return documents.Results, err
+}
+
+//Writes the file of a document, usually a PDF, to w.
+func (b *Billing) Download(fileId string, w io.Writer) error {
+
+    resp, err := b.client.NewRequest(http.MethodGet, billingPath+"/legal_document/"+fileId).Do()
+
+    if err != nil {
+        return err
+    }
+
+    if resp.StatusCode >= http.StatusBadRequest {
+        return decodeResponse(resp, nil)
+    }
+
+    defer resp.Body.Close()
+
+    _, err = io.Copy(w, resp.Body)
+    return err
+}
+
+/*
+Returns the total of the charges per currency and type, for instance totals["ARS"]["CFEE"] for the sale fees in pesos.
+Charges in different currencies are never added together.
+*/
+func TotalsByCurrencyAndType(charges []BillingCharge) map[string]map[string]Money {
+
+    totals := map[string]map[string]Money{}
+
+    for _, charge := range charges {
+        if totals[charge.CurrencyId] == nil {
+            totals[charge.CurrencyId] = map[string]Money{}
+        }
+        totals[charge.CurrencyId][charge.Type] += charge.Amount
+    }
+
+    return totals
+}
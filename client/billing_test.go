@@ -0,0 +1,89 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "bytes"
+    "fmt"
+)
+
+func Test_charges_of_a_period_are_read_from_every_page_and_totaled(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path != "/billing/integration/periods/key/2016-06-01/group/ML/details" {
+            w.WriteHeader(http.StatusNotFound)
+            return
+        }
+        offset := r.URL.Query().Get("offset")
+        fmt.Fprintf(w, "{\"offset\":%s,\"limit\":50,\"total\":70,\"results\":["+
+            "{\"charge_info\":{\"detail_id\":1%s,\"detail_type\":\"CFEE\",\"detail_amount\":10.10,\"currency_id\":\"ARS\"},\"sales_info\":[{\"order_id\":2000}]},"+
+            "{\"charge_info\":{\"detail_id\":2%s,\"detail_type\":\"SHIPPING\",\"detail_amount\":5,\"currency_id\":\"ARS\"}},"+
+            "{\"charge_info\":{\"detail_id\":3%s,\"detail_type\":\"CFEE\",\"detail_amount\":1,\"currency_id\":\"USD\"}}]}", offset, offset, offset, offset)
+    })
+    defer server.Close()
+
+    charges, err := client.Billing().Charges(BILLING_GROUP_ML, "2016-06-01")
+
+    if err != nil || len(charges) != 6 {
+        log.Printf("unexpected charges %v %v\n", charges, err)
+        t.FailNow()
+    }
+
+    totals := TotalsByCurrencyAndType(charges)
+
+    if totals["ARS"]["CFEE"].String() != "20.2" || totals["ARS"]["SHIPPING"] != MoneyFromInt(10) || totals["USD"]["CFEE"] != MoneyFromInt(2) {
+        log.Printf("unexpected totals %v\n", totals)
+        t.FailNow()
+    }
+}
+
+func Test_invoices_are_downloaded_to_the_writer(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/billing/integration/periods/key/2016-06-01/documents":
+            w.Write([]byte("{\"results\":[{\"id\":9,\"document_type\":\"BILL\",\"files\":[{\"file_id\":\"f-9\"}]}]}"))
+        case "/billing/integration/legal_document/f-9":
+            w.Write([]byte("%PDF-1.4"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    documents, err := client.Billing().Documents(BILLING_GROUP_ML, "2016-06-01")
+
+    if err != nil || len(documents) != 1 || documents[0].Files[0].FileId != "f-9" {
+        log.Printf("unexpected documents %v %v\n", documents, err)
+        t.FailNow()
+    }
+
+    var out bytes.Buffer
+
+    if err := client.Billing().Download("f-9", &out); err != nil || out.String() != "%PDF-1.4" {
+        log.Printf("unexpected document %s %v\n", out.String(), err)
+        t.FailNow()
+    }
+
+    if err := client.Billing().Download("missing", &out); err == nil {
+        log.Printf("missing documents should fail\n")
+        t.FailNow()
+    }
+}
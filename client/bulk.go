@@ -0,0 +1,322 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "bufio"
+    "crypto/sha1"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "log"
+    "net"
+    "net/http"
+    "os"
+    "strconv"
+    "sync"
+    "time"
+)
+
+const (
+    DEFAULT_BULK_WORKERS     = 4
+    DEFAULT_BULK_RATE        = 10 // requests per second
+    DEFAULT_BULK_MAX_RETRIES = 3
+    DEFAULT_BULK_RETRY_WAIT  = time.Second
+
+    //MaxRetries which disables the retries.
+    NO_BULK_RETRIES = -1
+)
+
+/*
+ItemUpdate is a change to be applied to an item through PUT /items/{id}.
+Body may be any value that can be encoded as JSON, for instance map[string]interface{}{"price": 10}.
+*/
+type ItemUpdate struct {
+    ItemId string
+    Body   interface{}
+}
+
+/*
+BulkResult tells what happened with each ItemUpdate. Skipped is true when the update was already applied
+according to the journal of a previous run.
+*/
+type BulkResult struct {
+    ItemId     string
+    StatusCode int
+    Attempts   int
+    Skipped    bool
+    Err        error
+}
+
+type BulkProgress struct {
+    Done      int
+    Succeeded int
+    Failed    int
+    Skipped   int
+}
+
+/*
+BulkOptions configures BulkUpdateItems. Zero values take the defaults.
+RequestsPerSecond is shared by all the workers and includes retries.
+Since MaxRetries 0 takes the default, a negative MaxRetries (such as NO_BULK_RETRIES) disables the retries.
+When Journal is set, every result is appended to that file; running again with the same file skips the
+updates that were already applied, so that a crashed run continues where it stopped.
+*/
+type BulkOptions struct {
+    Workers           int
+    RequestsPerSecond int
+    MaxRetries        int
+    RetryWait         time.Duration
+    Journal           string
+    Progress          func(BulkProgress)
+}
+
+/*
+This method applies the updates read from the channel until it is closed. Results are sent through the
+returned channel, which is closed once every update was processed, so it has to be drained.
+*/
+func (client *Client) BulkUpdateItems(updates <-chan ItemUpdate, options BulkOptions) (<-chan BulkResult, error) {
+
+    if options.Workers <= 0 {
+        options.Workers = DEFAULT_BULK_WORKERS
+    }
+
+    if options.RequestsPerSecond <= 0 {
+        options.RequestsPerSecond = DEFAULT_BULK_RATE
+    }
+
+    if options.MaxRetries < 0 {
+        options.MaxRetries = 0
+    } else if options.MaxRetries == 0 {
+        options.MaxRetries = DEFAULT_BULK_MAX_RETRIES
+    }
+
+    if options.RetryWait <= 0 {
+        options.RetryWait = DEFAULT_BULK_RETRY_WAIT
+    }
+
+    journal, err := openBulkJournal(options.Journal)
+
+    if err != nil {
+        log.Printf("Error while opening the journal %s: %s\n", options.Journal, err)
+        return nil, err
+    }
+
+    results := make(chan BulkResult)
+    //Rates above one request per nanosecond are not limited any further.
+    interval := time.Second / time.Duration(options.RequestsPerSecond)
+    if interval <= 0 {
+        interval = time.Nanosecond
+    }
+
+    limiter := time.NewTicker(interval)
+
+    var progress BulkProgress
+    var progressMutex sync.Mutex
+    var wg sync.WaitGroup
+
+    report := func(result BulkResult) {
+        progressMutex.Lock()
+        progress.Done++
+        switch {
+        case result.Skipped:
+            progress.Skipped++
+        case result.Err != nil:
+            progress.Failed++
+        default:
+            progress.Succeeded++
+        }
+        if options.Progress != nil {
+            options.Progress(progress)
+        }
+        progressMutex.Unlock()
+    }
+
+    wg.Add(options.Workers)
+    for i := 0; i < options.Workers; i++ {
+        go func() {
+            defer wg.Done()
+            for update := range updates {
+                key := bulkKey(update)
+
+                var result BulkResult
+
+                if journal.isDone(key) {
+                    result = BulkResult{ItemId: update.ItemId, Skipped: true}
+                } else {
+                    result = client.bulkUpdate(update, options, limiter.C)
+                    journal.record(key, result)
+                }
+
+                report(result)
+                results <- result
+            }
+        }()
+    }
+
+    go func() {
+        wg.Wait()
+        limiter.Stop()
+        journal.close()
+        close(results)
+    }()
+
+    return results, nil
+}
+
+//Network errors, 429 and 5xx are retried. Any other error, such as a body which may not be encoded, is returned right away.
+func (client *Client) bulkUpdate(update ItemUpdate, options BulkOptions, limiter <-chan time.Time) BulkResult {
+
+    result := BulkResult{ItemId: update.ItemId}
+    wait := options.RetryWait
+
+    for attempt := 1; attempt <= options.MaxRetries+1; attempt++ {
+
+        <-limiter
+        result.Attempts = attempt
+
+        resp, err := client.NewRequest(http.MethodPut, "/items/"+update.ItemId).JSON(update.Body).Do()
+
+        var netErr net.Error
+        retry := errors.As(err, &netErr)
+
+        if err == nil {
+            result.StatusCode = resp.StatusCode
+            retryAfter := resp.Header.Get("Retry-After")
+            err = decodeResponse(resp, nil)
+            retry = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+
+            if seconds, convErr := strconv.Atoi(retryAfter); convErr == nil && seconds > 0 {
+                wait = time.Duration(seconds) * time.Second
+            }
+        }
+
+        result.Err = err
+
+        if err == nil || !retry || attempt > options.MaxRetries {
+            break
+        }
+
+        log.Printf("Error while updating item %s (attempt %d): %s\n", update.ItemId, attempt, err)
+        time.Sleep(wait)
+        wait *= 2
+    }
+
+    return result
+}
+
+//The key identifies the item and the change, so the same item may be updated again with other values.
+func bulkKey(update ItemUpdate) string {
+
+    body, _ := json.Marshal(update.Body)
+    hash := sha1.Sum(append([]byte(update.ItemId+"\n"), body...))
+
+    return hex.EncodeToString(hash[:])
+}
+
+type bulkJournalEntry struct {
+    Key        string `json:"key"`
+    ItemId     string `json:"item_id"`
+    StatusCode int    `json:"status_code"`
+    Attempts   int    `json:"attempts"`
+    Error      string `json:"error,omitempty"`
+    At         int64  `json:"at"`
+}
+
+/*
+The journal is a file with one JSON entry per line. A nil journal does nothing.
+*/
+type bulkJournal struct {
+    mutex   sync.Mutex
+    file    *os.File
+    encoder *json.Encoder
+    done    map[string]bool
+}
+
+func openBulkJournal(path string) (*bulkJournal, error) {
+
+    if path == "" {
+        return nil, nil
+    }
+
+    journal := &bulkJournal{done: map[string]bool{}}
+
+    if existing, err := os.Open(path); err == nil {
+        scanner := bufio.NewScanner(existing)
+        for scanner.Scan() {
+            var entry bulkJournalEntry
+            //The last line may be incomplete if the previous run crashed while writing it.
+            if json.Unmarshal(scanner.Bytes(), &entry) == nil && entry.Error == "" {
+                journal.done[entry.Key] = true
+            }
+        }
+        existing.Close()
+    }
+
+    file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+
+    if err != nil {
+        return nil, err
+    }
+
+    journal.file = file
+    journal.encoder = json.NewEncoder(file)
+
+    return journal, nil
+}
+
+func (j *bulkJournal) isDone(key string) bool {
+
+    if j == nil {
+        return false
+    }
+
+    j.mutex.Lock()
+    defer j.mutex.Unlock()
+
+    return j.done[key]
+}
+
+func (j *bulkJournal) record(key string, result BulkResult) {
+
+    if j == nil {
+        return
+    }
+
+    entry := bulkJournalEntry{Key: key, ItemId: result.ItemId, StatusCode: result.StatusCode, Attempts: result.Attempts, At: time.Now().Unix()}
+
+    if result.Err != nil {
+        entry.Error = result.Err.Error()
+    }
+
+    j.mutex.Lock()
+    defer j.mutex.Unlock()
+
+    if err := j.encoder.Encode(entry); err != nil {
+        log.Printf("Error while writing the journal: %s\n", err)
+    }
+
+    if result.Err == nil {
+        j.done[key] = true
+    }
+}
+
+func (j *bulkJournal) close() {
+    if j != nil {
+        j.file.Close()
+    }
+}
@@ -0,0 +1,183 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "path/filepath"
+    "strconv"
+    "sync"
+    "time"
+)
+
+func itemUpdates(count int) <-chan ItemUpdate {
+
+    updates := make(chan ItemUpdate)
+
+    go func() {
+        for i := 0; i < count; i++ {
+            updates <- ItemUpdate{ItemId:"MLA" + strconv.Itoa(i), Body:map[string]interface{}{"available_quantity": i}}
+        }
+        close(updates)
+    }()
+
+    return updates
+}
+
+func Test_bulk_update_retries_server_errors_and_reports_progress(t *testing.T) {
+
+    var mutex sync.Mutex
+    calls := map[string]int{}
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        mutex.Lock()
+        calls[r.URL.Path]++
+        first := calls[r.URL.Path] == 1
+        mutex.Unlock()
+
+        if r.URL.Path == "/items/MLA1" && first {
+            w.WriteHeader(http.StatusServiceUnavailable)
+            return
+        }
+        if r.URL.Path == "/items/MLA2" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        w.Write([]byte("{}"))
+    })
+    defer server.Close()
+
+    var last BulkProgress
+    options := BulkOptions{Workers:3, RequestsPerSecond:1000, RetryWait:time.Millisecond, Progress:func(p BulkProgress) { last = p }}
+
+    results, err := client.BulkUpdateItems(itemUpdates(5), options)
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    byItem := map[string]BulkResult{}
+    for result := range results {
+        byItem[result.ItemId] = result
+    }
+
+    if byItem["MLA1"].Err != nil || byItem["MLA1"].Attempts != 2 {
+        log.Printf("MLA1 should have been retried %v\n", byItem["MLA1"])
+        t.FailNow()
+    }
+
+    if byItem["MLA2"].Err == nil || byItem["MLA2"].Attempts != 1 {
+        log.Printf("MLA2 should have failed without retries %v\n", byItem["MLA2"])
+        t.FailNow()
+    }
+
+    if last.Done != 5 || last.Succeeded != 4 || last.Failed != 1 {
+        log.Printf("unexpected progress %v\n", last)
+        t.FailNow()
+    }
+}
+
+func Test_bulk_update_skips_the_updates_already_applied_according_to_the_journal(t *testing.T) {
+
+    var mutex sync.Mutex
+    calls := 0
+    failing := true
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        mutex.Lock()
+        defer mutex.Unlock()
+        calls++
+        if failing && r.URL.Path == "/items/MLA3" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        w.Write([]byte("{}"))
+    })
+    defer server.Close()
+
+    options := BulkOptions{RequestsPerSecond:1000, Journal:filepath.Join(t.TempDir(), "journal")}
+
+    results, _ := client.BulkUpdateItems(itemUpdates(5), options)
+    for range results {
+    }
+
+    mutex.Lock()
+    calls = 0
+    failing = false
+    mutex.Unlock()
+
+    skipped := 0
+    results, _ = client.BulkUpdateItems(itemUpdates(5), options)
+    for result := range results {
+        if result.Skipped {
+            skipped++
+        }
+    }
+
+    if skipped != 4 || calls != 1 {
+        log.Printf("only the failed update should have been sent again. skipped:%d calls:%d\n", skipped, calls)
+        t.FailNow()
+    }
+}
+
+func Test_bulk_update_accepts_huge_rates_and_disabling_retries(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(http.StatusServiceUnavailable)
+    })
+    defer server.Close()
+
+    options := BulkOptions{RequestsPerSecond:2000000000, MaxRetries:NO_BULK_RETRIES}
+
+    results, err := client.BulkUpdateItems(itemUpdates(3), options)
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    for result := range results {
+        if result.Err == nil || result.Attempts != 1 {
+            log.Printf("%s should have failed without retries %v\n", result.ItemId, result)
+            t.FailNow()
+        }
+    }
+}
+
+func Test_bulk_update_does_not_retry_local_errors(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte("{}"))
+    })
+    defer server.Close()
+
+    updates := make(chan ItemUpdate, 1)
+    updates <- ItemUpdate{ItemId: "MLA1", Body: make(chan int)}
+    close(updates)
+
+    results, _ := client.BulkUpdateItems(updates, BulkOptions{RequestsPerSecond:1000, RetryWait:time.Millisecond})
+
+    for result := range results {
+        if result.Err == nil || result.Attempts != 1 {
+            log.Printf("a body which may not be encoded should fail without retries %v\n", result)
+            t.FailNow()
+        }
+    }
+}
@@ -0,0 +1,134 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "encoding/json"
+    "io/ioutil"
+    "log"
+    "net/http"
+    "regexp"
+    "strconv"
+    "sync"
+    "time"
+)
+
+var maxAgeRegexp = regexp.MustCompile(`max-age=(\d+)`)
+
+/*
+ResponseCache keeps the responses of public APIs which rarely change.
+An entry is fresh during the TTL (or the max-age sent by the API). Once it is stale, it is validated with its ETag,
+so the body is downloaded again only when it has changed.
+*/
+type ResponseCache struct {
+    mutex   sync.Mutex
+    ttl     time.Duration
+    entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+    body    []byte
+    etag    string
+    expires time.Time
+}
+
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+    return &ResponseCache{ttl: ttl, entries: map[string]*cacheEntry{}}
+}
+
+//Removes every entry.
+func (c *ResponseCache) Clear() {
+    c.mutex.Lock()
+    c.entries = map[string]*cacheEntry{}
+    c.mutex.Unlock()
+}
+
+func (c *ResponseCache) get(key string) *cacheEntry {
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+    return c.entries[key]
+}
+
+func (c *ResponseCache) put(key string, entry *cacheEntry) {
+    c.mutex.Lock()
+    c.entries[key] = entry
+    c.mutex.Unlock()
+}
+
+func (c *ResponseCache) expiration(resp *http.Response) time.Time {
+
+    ttl := c.ttl
+
+    if match := maxAgeRegexp.FindStringSubmatch(resp.Header.Get("Cache-Control")); match != nil {
+        if seconds, err := strconv.Atoi(match[1]); err == nil && seconds > 0 {
+            ttl = time.Duration(seconds) * time.Second
+        }
+    }
+
+    return time.Now().Add(ttl)
+}
+
+/*
+This method GETs the resource through the given cache and decodes it into v.
+The cache key does not include the token, so it may only be used for public resources.
+*/
+func (client *Client) getCached(cache *ResponseCache, resourcePath string, v interface{}) error {
+
+    key := client.ApiUrl + resourcePath
+    entry := cache.get(key)
+
+    if entry != nil && time.Now().Before(entry.expires) {
+        return json.Unmarshal(entry.body, v)
+    }
+
+    request := client.NewRequest(http.MethodGet, resourcePath)
+
+    if entry != nil && entry.etag != "" {
+        request.Header("If-None-Match", entry.etag)
+    }
+
+    resp, err := request.Do()
+
+    if err != nil {
+        return err
+    }
+
+    if resp.StatusCode == http.StatusNotModified && entry != nil {
+        resp.Body.Close()
+        cache.put(key, &cacheEntry{body: entry.body, etag: entry.etag, expires: cache.expiration(resp)})
+        return json.Unmarshal(entry.body, v)
+    }
+
+    if resp.StatusCode != http.StatusOK {
+        return decodeResponse(resp, v)
+    }
+
+    body, err := ioutil.ReadAll(resp.Body)
+    resp.Body.Close()
+
+    if err != nil {
+        return err
+    }
+
+    if err := json.Unmarshal(body, v); err != nil {
+        log.Printf("Error while decoding the response %s %s", err.Error(), body)
+        return err
+    }
+
+    cache.put(key, &cacheEntry{body: body, etag: resp.Header.Get("ETag"), expires: cache.expiration(resp)})
+    return nil
+}
@@ -0,0 +1,268 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "net/url"
+)
+
+const (
+    //Status of the catalog eligibility of an item
+    CATALOG_READY_FOR_OPTIN  = "READY_FOR_OPTIN"
+    CATALOG_ALREADY_OPTED_IN = "ALREADY_OPTED_IN"
+    CATALOG_NOT_ELIGIBLE     = "NOT_ELIGIBLE"
+    CATALOG_PRODUCT_INACTIVE = "PRODUCT_INACTIVE"
+
+    //Status of an item in the buy box competition
+    BUY_BOX_WINNING             = "winning"
+    BUY_BOX_SHARING_FIRST_PLACE = "sharing_first_place"
+    BUY_BOX_COMPETING           = "competing"
+    BUY_BOX_LISTED              = "listed"
+)
+
+type Product struct {
+    Id           string          `json:"id"`
+    Name         string          `json:"name"`
+    Status       string          `json:"status"`
+    SiteId       string          `json:"site_id"`
+    DomainId     string          `json:"domain_id"`
+    Permalink    string          `json:"permalink"`
+    Attributes   []ItemAttribute `json:"attributes"`
+    Pictures     []ItemPicture   `json:"pictures"`
+    BuyBoxWinner *BuyBoxWinner   `json:"buy_box_winner"`
+}
+
+type BuyBoxWinner struct {
+    ItemId     string `json:"item_id"`
+    SellerId   int64  `json:"seller_id"`
+    Price      Money  `json:"price"`
+    CurrencyId string `json:"currency_id"`
+}
+
+//Page decoded by the Pagers of Catalog.Search.
+type ProductSearch struct {
+    Paging  Paging    `json:"paging"`
+    Results []Product `json:"results"`
+}
+
+/*
+CatalogEligibility tells whether an item, or each of its variations, may be opted into the catalog.
+*/
+type CatalogEligibility struct {
+    Id             string `json:"id"`
+    SiteId         string `json:"site_id"`
+    DomainId       string `json:"domain_id"`
+    BuyBoxEligible bool   `json:"buy_box_eligible"`
+    Status         string `json:"status"`
+    Variations     []struct {
+        Id             int64  `json:"id"`
+        BuyBoxEligible bool   `json:"buy_box_eligible"`
+        Status         string `json:"status"`
+    } `json:"variations"`
+}
+
+//Tells whether the item, or the variation when variationId is not 0, may be opted into the catalog.
+func (e *CatalogEligibility) CanOptIn(variationId int64) bool {
+
+    if variationId == 0 {
+        return e.Status == CATALOG_READY_FOR_OPTIN
+    }
+
+    for _, variation := range e.Variations {
+        if variation.Id == variationId {
+            return variation.Status == CATALOG_READY_FOR_OPTIN
+        }
+    }
+
+    return false
+}
+
+/*
+PriceToWin is the state of a catalog item in the buy box competition and the price it needs for winning it.
+*/
+type PriceToWin struct {
+    ItemId                       string   `json:"item_id"`
+    CurrentPrice                 Money    `json:"current_price"`
+    CurrencyId                   string   `json:"currency_id"`
+    PriceToWin                   Money    `json:"price_to_win"`
+    Status                       string   `json:"status"`
+    CompetitorsSharingFirstPlace int      `json:"competitors_sharing_first_place"`
+    VisitShare                   string   `json:"visit_share"`
+    Reason                       []string `json:"reason"`
+    Winner                       *struct {
+        ItemId     string `json:"item_id"`
+        Price      Money  `json:"price"`
+        CurrencyId string `json:"currency_id"`
+    } `json:"winner"`
+}
+
+//Tells whether the item wins the buy box, alone or sharing it.
+func (p *PriceToWin) IsWinning() bool {
+    return p.Status == BUY_BOX_WINNING || p.Status == BUY_BOX_SHARING_FIRST_PLACE
+}
+
+//Returns how much the price has to drop for winning the buy box; 0 when it is already winning or there is no price to win.
+func (p *PriceToWin) Gap() Money {
+
+    if p.IsWinning() || p.PriceToWin <= 0 || p.PriceToWin >= p.CurrentPrice {
+        return 0
+    }
+
+    return p.CurrentPrice - p.PriceToWin
+}
+
+/*
+Catalog gives typed access to the catalog products and the catalog listings competing for their buy box.
+*/
+type Catalog struct {
+    client *Client
+}
+
+func (client *Client) Catalog() *Catalog {
+    return &Catalog{client: client}
+}
+
+func (c *Catalog) Product(productId string) (*Product, error) {
+
+    product := new(Product)
+
+    if err := c.client.NewRequest(http.MethodGet, "/products/"+productId).Decode(product); err != nil {
+        return nil, err
+    }
+
+    return product, nil
+}
+
+/*
+This method returns a Pager over the active products of the site matching q. Each page is decoded into a ProductSearch.
+*/
+func (c *Catalog) Search(siteId string, q string, limit int) *Pager {
+
+    params := url.Values{}
+    params.Set("status", "active")
+    params.Set("site_id", siteId)
+    params.Set("q", q)
+
+    return c.client.NewPager("/products/search", params, limit)
+}
+
+func (c *Catalog) Eligibility(itemId string) (*CatalogEligibility, error) {
+
+    eligibility := new(CatalogEligibility)
+
+    if err := c.client.NewRequest(http.MethodGet, "/items/"+itemId+"/catalog_listing_eligibility").Decode(eligibility); err != nil {
+        return nil, err
+    }
+
+    return eligibility, nil
+}
+
+/*
+This method opts an existing item, or one of its variations when variationId is not 0, into the catalog product.
+Its eligibility is checked first. It returns the catalog listing created, which is a new item.
+*/
+func (c *Catalog) OptIn(itemId string, productId string, variationId int64) (*Item, error) {
+
+    eligibility, err := c.Eligibility(itemId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    if !eligibility.CanOptIn(variationId) {
+        return nil, fmt.Errorf("Item %s may not be opted into the catalog, its status is %s.", itemId, eligibility.Status)
+    }
+
+    body := struct {
+        ItemId           string `json:"item_id"`
+        CatalogProductId string `json:"catalog_product_id"`
+        VariationId      int64  `json:"variation_id,omitempty"`
+    }{ItemId: itemId, CatalogProductId: productId, VariationId: variationId}
+
+    listing := new(Item)
+
+    if err := c.client.NewRequest(http.MethodPost, "/items/catalog_listings").JSON(body).Decode(listing); err != nil {
+        return nil, err
+    }
+
+    return listing, nil
+}
+
+/*
+This method lists a new item straight into the catalog. The item needs CatalogProductId and its category, since
+the title and the attributes come from the product. The listing is created through Items.Create, so the description
+of the item is created as well.
+*/
+func (c *Catalog) CreateListing(item *Item) (*Item, error) {
+
+    if item.CatalogProductId == "" {
+        return nil, errors.New("The catalog product is needed for creating a catalog listing.")
+    }
+
+    if item.CategoryId == "" {
+        return nil, errors.New("The category is needed for creating a catalog listing.")
+    }
+
+    //The caller's item is left as it was.
+    listing := *item
+    listing.CatalogListing = true
+
+    return c.client.Items().Create(&listing)
+}
+
+func (c *Catalog) PriceToWin(itemId string) (*PriceToWin, error) {
+
+    price := new(PriceToWin)
+
+    err := c.client.NewRequest(http.MethodGet, "/items/"+itemId+"/price_to_win").
+        Query("siteId", siteOf(itemId)).
+        Query("version", "v2").
+        Decode(price)
+
+    if err != nil {
+        return nil, err
+    }
+
+    return price, nil
+}
+
+/*
+This method returns the buy box competition of the given catalog listings of the seller. Listings which fail are
+skipped and their errors returned together, keyed by item id.
+*/
+func (c *Catalog) Competition(itemIds []string) ([]PriceToWin, map[string]error) {
+
+    prices := make([]PriceToWin, 0, len(itemIds))
+    failed := map[string]error{}
+
+    for _, itemId := range itemIds {
+
+        price, err := c.PriceToWin(itemId)
+
+        if err != nil {
+            failed[itemId] = err
+            continue
+        }
+
+        prices = append(prices, *price)
+    }
+
+    return prices, failed
+}
@@ -0,0 +1,139 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "encoding/json"
+)
+
+func Test_items_are_opted_into_the_catalog_only_when_eligible(t *testing.T) {
+
+    var received map[string]interface{}
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/items/MLA1/catalog_listing_eligibility":
+            w.Write([]byte("{\"id\":\"MLA1\",\"status\":\"READY_FOR_OPTIN\",\"buy_box_eligible\":true}"))
+        case "/items/MLA2/catalog_listing_eligibility":
+            w.Write([]byte("{\"id\":\"MLA2\",\"status\":\"NOT_ELIGIBLE\",\"variations\":[{\"id\":5,\"status\":\"READY_FOR_OPTIN\"}]}"))
+        case "/items/catalog_listings":
+            json.NewDecoder(r.Body).Decode(&received)
+            w.Write([]byte("{\"id\":\"MLA9\",\"catalog_product_id\":\"MLA6009\",\"catalog_listing\":true}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    listing, err := client.Catalog().OptIn("MLA1", "MLA6009", 0)
+
+    if err != nil || !listing.CatalogListing || received["item_id"] != "MLA1" || received["catalog_product_id"] != "MLA6009" {
+        log.Printf("unexpected listing %v %v %v\n", listing, received, err)
+        t.FailNow()
+    }
+
+    if _, err := client.Catalog().OptIn("MLA2", "MLA6009", 0); err == nil {
+        log.Printf("items which are not eligible should not be opted in\n")
+        t.FailNow()
+    }
+
+    if _, err := client.Catalog().OptIn("MLA2", "MLA6009", 5); err != nil || received["variation_id"] != 5.0 {
+        log.Printf("eligible variations should be opted in %v %v\n", received, err)
+        t.FailNow()
+    }
+}
+
+func Test_buy_box_competition_tells_the_price_to_win(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Query().Get("siteId") != "MLA" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        switch r.URL.Path {
+        case "/items/MLA1/price_to_win":
+            w.Write([]byte("{\"item_id\":\"MLA1\",\"current_price\":1000,\"price_to_win\":949.99,\"status\":\"competing\",\"winner\":{\"item_id\":\"MLA7\",\"price\":950}}"))
+        case "/items/MLA2/price_to_win":
+            w.Write([]byte("{\"item_id\":\"MLA2\",\"current_price\":900,\"status\":\"winning\"}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    prices, failed := client.Catalog().Competition([]string{"MLA1", "MLA2", "MLA3"})
+
+    if len(prices) != 2 || len(failed) != 1 || failed["MLA3"] == nil {
+        log.Printf("unexpected competition %v %v\n", prices, failed)
+        t.FailNow()
+    }
+
+    if prices[0].IsWinning() || prices[0].Gap().String() != "50.01" || prices[0].Winner.ItemId != "MLA7" || !prices[1].IsWinning() || prices[1].Gap() != 0 {
+        log.Printf("unexpected prices to win %v\n", prices)
+        t.FailNow()
+    }
+}
+
+func Test_catalog_listings_need_the_product(t *testing.T) {
+
+    client := &Client{}
+
+    if _, err := client.Catalog().CreateListing(&Item{CategoryId: "MLA1055", Price: MoneyFromInt(10)}); err == nil {
+        log.Printf("catalog listings without product should fail\n")
+        t.FailNow()
+    }
+}
+
+func Test_catalog_listings_are_created_with_their_description_without_changing_the_given_item(t *testing.T) {
+
+    var received map[string]interface{}
+    var description map[string]interface{}
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/items":
+            json.NewDecoder(r.Body).Decode(&received)
+            w.Write([]byte("{\"id\":\"MLA9\",\"catalog_product_id\":\"MLA6009\",\"catalog_listing\":true}"))
+        case "/items/MLA9/description":
+            json.NewDecoder(r.Body).Decode(&description)
+            w.Write([]byte("{\"plain_text\":\"Nuevo en caja\"}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    item := &Item{CategoryId: "MLA1055", CatalogProductId: "MLA6009", Price: MoneyFromInt(10), Description: "Nuevo en caja"}
+
+    listing, err := client.Catalog().CreateListing(item)
+
+    if err != nil || listing.Id != "MLA9" || received["catalog_listing"] != true {
+        log.Printf("unexpected listing %v %v %v\n", listing, received, err)
+        t.FailNow()
+    }
+
+    if description["plain_text"] != "Nuevo en caja" || listing.Description != "Nuevo en caja" {
+        log.Printf("the description should be created %v %v\n", description, listing)
+        t.FailNow()
+    }
+
+    if item.CatalogListing {
+        log.Printf("the given item should not be changed\n")
+        t.FailNow()
+    }
+}
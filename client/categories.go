@@ -0,0 +1,249 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "time"
+)
+
+const DEFAULT_CATEGORIES_TTL = 6 * time.Hour
+
+/*
+Categories and their attributes are public and change rarely, so their responses are kept in this cache,
+which is shared by every client (anonymous clients created through NewAnonymousClient included).
+*/
+var CategoriesCache = NewResponseCache(DEFAULT_CATEGORIES_TTL)
+
+//Returned by a WalkFunc for not visiting the children of a category.
+var SkipChildren = errors.New("skip the children of this category")
+
+type CategoryRef struct {
+    Id                       string `json:"id"`
+    Name                     string `json:"name"`
+    TotalItemsInThisCategory int64  `json:"total_items_in_this_category,omitempty"`
+}
+
+type Category struct {
+    Id                       string           `json:"id"`
+    Name                     string           `json:"name"`
+    Picture                  string           `json:"picture"`
+    Permalink                string           `json:"permalink"`
+    TotalItemsInThisCategory int64            `json:"total_items_in_this_category"`
+    PathFromRoot             []CategoryRef    `json:"path_from_root"`
+    ChildrenCategories       []CategoryRef    `json:"children_categories"`
+    Settings                 CategorySettings `json:"settings"`
+}
+
+type CategorySettings struct {
+    AdultContent         bool     `json:"adult_content"`
+    BuyingAllowed        bool     `json:"buying_allowed"`
+    BuyingModes          []string `json:"buying_modes"`
+    CatalogDomain        string   `json:"catalog_domain"`
+    Currencies           []string `json:"currencies"`
+    ItemConditions       []string `json:"item_conditions"`
+    ListingAllowed       bool     `json:"listing_allowed"`
+    MaxDescriptionLength int      `json:"max_description_length"`
+    MaxPicturesPerItem   int      `json:"max_pictures_per_item"`
+    MaxSubTitleLength    int      `json:"max_sub_title_length"`
+    MaxTitleLength       int      `json:"max_title_length"`
+    MaximumPrice         Money    `json:"maximum_price"`
+    MinimumPrice         Money    `json:"minimum_price"`
+    ShippingModes        []string `json:"shipping_modes"`
+    Status               string   `json:"status"`
+}
+
+//Tells whether the category is a leaf, the only ones where items may be listed.
+func (c *Category) IsLeaf() bool {
+    return len(c.ChildrenCategories) == 0
+}
+
+type Attribute struct {
+    Id             string           `json:"id"`
+    Name           string           `json:"name"`
+    Tags           AttributeTags    `json:"tags"`
+    Hierarchy      string           `json:"hierarchy"`
+    Relevance      int              `json:"relevance"`
+    ValueType      string           `json:"value_type"`
+    ValueMaxLength int              `json:"value_max_length"`
+    Values         []AttributeValue `json:"values"`
+    AllowedUnits   []AttributeValue `json:"allowed_units"`
+    DefaultUnit    string           `json:"default_unit"`
+    Tooltip        string           `json:"tooltip"`
+    AttributeGroup string           `json:"attribute_group_id"`
+}
+
+type AttributeTags struct {
+    Required            bool `json:"required"`
+    CatalogRequired     bool `json:"catalog_required"`
+    ConditionalRequired bool `json:"conditional_required"`
+    AllowVariations     bool `json:"allow_variations"`
+    VariationAttribute  bool `json:"variation_attribute"`
+    DefinesPicture      bool `json:"defines_picture"`
+    Hidden              bool `json:"hidden"`
+    ReadOnly            bool `json:"read_only"`
+    MultiValued         bool `json:"multivalued"`
+}
+
+type AttributeValue struct {
+    Id   string `json:"id"`
+    Name string `json:"name"`
+}
+
+func (a *Attribute) IsRequired() bool {
+    return a.Tags.Required || a.Tags.CatalogRequired
+}
+
+/*
+Tells whether the given value id or name is one of the allowed values.
+Attributes without a list of values accept any value.
+*/
+func (a *Attribute) Allows(value string) bool {
+
+    if len(a.Values) == 0 {
+        return true
+    }
+
+    for _, allowed := range a.Values {
+        if allowed.Id == value || allowed.Name == value {
+            return true
+        }
+    }
+
+    return false
+}
+
+//Tells whether the unit is accepted by an attribute of type number_unit.
+func (a *Attribute) AllowsUnit(unit string) bool {
+
+    for _, allowed := range a.AllowedUnits {
+        if allowed.Id == unit || allowed.Name == unit {
+            return true
+        }
+    }
+
+    return false
+}
+
+/*
+Categories gives typed access to the categories API.
+*/
+type Categories struct {
+    client *Client
+}
+
+func (client *Client) Categories() *Categories {
+    return &Categories{client: client}
+}
+
+//Returns the root categories of the site, for instance MLA.
+func (c *Categories) ForSite(siteId string) ([]CategoryRef, error) {
+
+    var categories []CategoryRef
+    err := c.client.getCached(CategoriesCache, "/sites/"+siteId+"/categories", &categories)
+
+    return categories, err
+}
+
+func (c *Categories) Get(categoryId string) (*Category, error) {
+
+    category := new(Category)
+
+    if err := c.client.getCached(CategoriesCache, "/categories/"+categoryId, category); err != nil {
+        return nil, err
+    }
+
+    return category, nil
+}
+
+func (c *Categories) Attributes(categoryId string) ([]Attribute, error) {
+
+    var attributes []Attribute
+    err := c.client.getCached(CategoriesCache, "/categories/"+categoryId+"/attributes", &attributes)
+
+    return attributes, err
+}
+
+//Returns only the attributes which are required for listing in the category.
+func (c *Categories) RequiredAttributes(categoryId string) ([]Attribute, error) {
+
+    attributes, err := c.Attributes(categoryId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    var required []Attribute
+
+    for _, attribute := range attributes {
+        if attribute.IsRequired() {
+            required = append(required, attribute)
+        }
+    }
+
+    return required, nil
+}
+
+/*
+WalkFunc is called for each category visited by Walk. depth is 0 for the root categories.
+Returning SkipChildren avoids visiting the children; any other error stops the walk.
+*/
+type WalkFunc func(category *Category, depth int) error
+
+/*
+This method visits the whole category tree of the site, depth first.
+Each category is fetched once, so walking again is served by the cache.
+*/
+func (c *Categories) Walk(siteId string, fn WalkFunc) error {
+
+    roots, err := c.ForSite(siteId)
+
+    if err != nil {
+        return err
+    }
+
+    for _, root := range roots {
+        if err := c.walk(root.Id, 0, fn); err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
+
+func (c *Categories) walk(categoryId string, depth int, fn WalkFunc) error {
+
+    category, err := c.Get(categoryId)
+
+    if err != nil {
+        return err
+    }
+
+    if err := fn(category, depth); err == SkipChildren {
+        return nil
+    } else if err != nil {
+        return err
+    }
+
+    for _, child := range category.ChildrenCategories {
+        if err := c.walk(child.Id, depth+1, fn); err != nil {
+            return err
+        }
+    }
+
+    return nil
+}
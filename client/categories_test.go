@@ -0,0 +1,139 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "net/http/httptest"
+    "time"
+)
+
+var testCategories = map[string]string{
+    "/sites/MLA/categories": "[{\"id\":\"MLA1\",\"name\":\"Root\"}]",
+    "/categories/MLA1": "{\"id\":\"MLA1\",\"name\":\"Root\",\"children_categories\":[{\"id\":\"MLA2\"},{\"id\":\"MLA3\"}]}",
+    "/categories/MLA2": "{\"id\":\"MLA2\",\"name\":\"Leaf\",\"children_categories\":[]}",
+    "/categories/MLA3": "{\"id\":\"MLA3\",\"name\":\"Skipped\",\"children_categories\":[{\"id\":\"MLA4\"}]}",
+    "/categories/MLA2/attributes": "[{\"id\":\"BRAND\",\"tags\":{\"required\":true},\"values\":[{\"id\":\"1\",\"name\":\"Ray-Ban\"}]},{\"id\":\"COLOR\",\"tags\":{}}]",
+}
+
+func newTestCategoriesServer(calls *int) *httptest.Server {
+    return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        *calls++
+        body, ok := testCategories[r.URL.Path]
+        if !ok {
+            w.WriteHeader(http.StatusNotFound)
+            return
+        }
+        if r.Header.Get("If-None-Match") == "\"v1\"" {
+            w.WriteHeader(http.StatusNotModified)
+            return
+        }
+        w.Header().Set("ETag", "\"v1\"")
+        w.Write([]byte(body))
+    }))
+}
+
+func Test_categories_are_cached_and_shared_by_anonymous_clients(t *testing.T) {
+
+    calls := 0
+    server := newTestCategoriesServer(&calls)
+    defer server.Close()
+    CategoriesCache.Clear()
+
+    first, _ := newTestAnonymousClient(server.URL)
+    second, _ := newTestAnonymousClient(server.URL)
+
+    category, err := first.Categories().Get("MLA2")
+
+    if err != nil || category.Name != "Leaf" || !category.IsLeaf() {
+        log.Printf("unexpected category %v %v\n", category, err)
+        t.FailNow()
+    }
+
+    if _, err := second.Categories().Get("MLA2"); err != nil || calls != 1 {
+        log.Printf("the category should have been taken from the cache. calls: %d\n", calls)
+        t.FailNow()
+    }
+}
+
+func Test_stale_categories_are_validated_with_their_etag(t *testing.T) {
+
+    calls := 0
+    server := newTestCategoriesServer(&calls)
+    defer server.Close()
+
+    cache := NewResponseCache(time.Nanosecond)
+    client, _ := newTestAnonymousClient(server.URL)
+
+    var first, second Category
+    client.getCached(cache, "/categories/MLA2", &first)
+    time.Sleep(time.Millisecond)
+    err := client.getCached(cache, "/categories/MLA2", &second)
+
+    if err != nil || calls != 2 || second.Name != "Leaf" {
+        log.Printf("the cached body should have been used after a 304. calls:%d err:%v\n", calls, err)
+        t.FailNow()
+    }
+}
+
+func Test_walk_visits_the_category_tree_and_may_skip_children(t *testing.T) {
+
+    calls := 0
+    server := newTestCategoriesServer(&calls)
+    defer server.Close()
+    CategoriesCache.Clear()
+
+    client, _ := newTestAnonymousClient(server.URL)
+
+    var visited []string
+    err := client.Categories().Walk("MLA", func(category *Category, depth int) error {
+        visited = append(visited, category.Id)
+        if category.Id == "MLA3" {
+            return SkipChildren
+        }
+        return nil
+    })
+
+    if err != nil || len(visited) != 3 || visited[0] != "MLA1" || visited[1] != "MLA2" || visited[2] != "MLA3" {
+        log.Printf("unexpected walk %v %v\n", visited, err)
+        t.FailNow()
+    }
+}
+
+func Test_required_attributes_and_allowed_values(t *testing.T) {
+
+    calls := 0
+    server := newTestCategoriesServer(&calls)
+    defer server.Close()
+    CategoriesCache.Clear()
+
+    client, _ := newTestAnonymousClient(server.URL)
+
+    required, err := client.Categories().RequiredAttributes("MLA2")
+
+    if err != nil || len(required) != 1 || required[0].Id != "BRAND" {
+        log.Printf("unexpected required attributes %v %v\n", required, err)
+        t.FailNow()
+    }
+
+    if !required[0].Allows("Ray-Ban") || required[0].Allows("Oakley") {
+        log.Printf("allowed values were not properly checked\n")
+        t.FailNow()
+    }
+}
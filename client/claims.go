@@ -0,0 +1,297 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "net/http"
+    "net/url"
+    "strconv"
+    "strings"
+    "time"
+)
+
+const (
+    CLAIM_OPENED = "opened"
+    CLAIM_CLOSED = "closed"
+
+    //Stages of a claim
+    CLAIM_STAGE_CLAIM   = "claim"
+    CLAIM_STAGE_DISPUTE = "dispute"
+    CLAIM_STAGE_RECALL  = "recall"
+
+    //Roles of the players of a claim
+    CLAIM_ROLE_COMPLAINANT = "complainant"
+    CLAIM_ROLE_RESPONDENT  = "respondent"
+    CLAIM_ROLE_MEDIATOR    = "mediator"
+
+    claimsPath = "/post-purchase/v1/claims"
+)
+
+type Claim struct {
+    Id          int64            `json:"id"`
+    ResourceId  int64            `json:"resource_id"`
+    Resource    string           `json:"resource"`
+    Status      string           `json:"status"`
+    Type        string           `json:"type"`
+    Stage       string           `json:"stage"`
+    ParentId    int64            `json:"parent_id"`
+    ReasonId    string           `json:"reason_id"`
+    SiteId      string           `json:"site_id"`
+    Players     []ClaimPlayer    `json:"players"`
+    Resolution  *ClaimResolution `json:"resolution"`
+    DateCreated string           `json:"date_created"`
+    LastUpdated string           `json:"last_updated"`
+}
+
+type ClaimPlayer struct {
+    Role             string        `json:"role"`
+    Type             string        `json:"type"`
+    UserId           int64         `json:"user_id"`
+    AvailableActions []ClaimAction `json:"available_actions"`
+}
+
+type ClaimAction struct {
+    Action    string `json:"action"`
+    Mandatory bool   `json:"mandatory"`
+    DueDate   string `json:"due_date"`
+}
+
+type ClaimResolution struct {
+    Reason      string `json:"reason"`
+    DateCreated string `json:"date_created"`
+    BenefitedId int64  `json:"benefited"`
+    ClosedBy    string `json:"closed_by"`
+}
+
+type ClaimMessage struct {
+    SenderRole   string `json:"sender_role"`
+    ReceiverRole string `json:"receiver_role"`
+    Message      string `json:"message"`
+    Stage        string `json:"stage"`
+    DateCreated  string `json:"date_created"`
+    Attachments  []struct {
+        Filename         string `json:"filename"`
+        OriginalFilename string `json:"original_filename"`
+        Size             int64  `json:"size"`
+    } `json:"attachments"`
+}
+
+type ClaimEvidence struct {
+    Type        string `json:"type"`
+    DateCreated string `json:"date_created"`
+    Attachments []struct {
+        Filename string `json:"filename"`
+    } `json:"attachments"`
+    ShippingMethod      string `json:"shipping_method"`
+    ShippingCompanyName string `json:"shipping_company_name"`
+    TrackingNumber      string `json:"tracking_number"`
+}
+
+type ClaimReturn struct {
+    Id          int64  `json:"id"`
+    ClaimId     int64  `json:"claim_id"`
+    Status      string `json:"status"`
+    Subtype     string `json:"subtype"`
+    StatusMoney string `json:"status_money"`
+    DateCreated string `json:"date_created"`
+    Shipments   []struct {
+        ShipmentId  int64  `json:"shipment_id"`
+        Status      string `json:"status"`
+        Type        string `json:"type"`
+        Destination struct {
+            Name string `json:"name"`
+        } `json:"destination"`
+    } `json:"shipments"`
+}
+
+/*
+ClaimSearch is a page of the claims found by Search.
+*/
+type ClaimSearch struct {
+    Paging Paging  `json:"paging"`
+    Data   []Claim `json:"data"`
+}
+
+//Returns the player of the claim with the given role, or nil when there is none.
+func (c *Claim) Player(role string) *ClaimPlayer {
+
+    for i := range c.Players {
+        if c.Players[i].Role == role {
+            return &c.Players[i]
+        }
+    }
+
+    return nil
+}
+
+/*
+This method returns the earliest due date of the mandatory actions the given role has to take, which is
+the deadline for answering the claim. ok is false when the role has nothing pending.
+*/
+func (c *Claim) Deadline(role string) (deadline time.Time, ok bool) {
+
+    player := c.Player(role)
+
+    if player == nil || c.Status != CLAIM_OPENED {
+        return deadline, false
+    }
+
+    for _, action := range player.AvailableActions {
+
+        if !action.Mandatory || action.DueDate == "" {
+            continue
+        }
+
+        due, err := time.Parse(time.RFC3339, action.DueDate)
+
+        if err != nil {
+            continue
+        }
+
+        if !ok || due.Before(deadline) {
+            deadline, ok = due, true
+        }
+    }
+
+    return deadline, ok
+}
+
+//Returns how long the seller (respondent) has left for answering the claim. It is negative when the deadline passed.
+func (c *Claim) TimeLeft(now time.Time) (time.Duration, bool) {
+
+    deadline, ok := c.Deadline(CLAIM_ROLE_RESPONDENT)
+
+    if !ok {
+        return 0, false
+    }
+
+    return deadline.Sub(now), true
+}
+
+/*
+Claims gives typed access to the claims (post-purchase) API.
+*/
+type Claims struct {
+    client *Client
+}
+
+func (client *Client) Claims() *Claims {
+    return &Claims{client: client}
+}
+
+func claimPath(claimId int64) string {
+    return claimsPath + "/" + strconv.FormatInt(claimId, 10)
+}
+
+func (c *Claims) Get(claimId int64) (*Claim, error) {
+
+    claim := new(Claim)
+
+    if err := c.client.NewRequest(http.MethodGet, claimPath(claimId)).Decode(claim); err != nil {
+        return nil, err
+    }
+
+    return claim, nil
+}
+
+/*
+This method returns a Pager over the claims of the seller with the given status and stage; empty values
+are not filtered. Each page is decoded into a ClaimSearch.
+*/
+func (c *Claims) Search(status string, stage string, limit int) *Pager {
+
+    params := url.Values{}
+
+    if status != "" {
+        params.Set("status", status)
+    }
+
+    if stage != "" {
+        params.Set("stage", stage)
+    }
+
+    return c.client.NewPager(claimsPath+"/search", params, limit)
+}
+
+func (c *Claims) Messages(claimId int64) ([]ClaimMessage, error) {
+
+    var messages []ClaimMessage
+    err := c.client.NewRequest(http.MethodGet, claimPath(claimId)+"/messages").Decode(&messages)
+
+    return messages, err
+}
+
+/*
+This method sends a message to the other part of the claim, CLAIM_ROLE_COMPLAINANT (the buyer) or CLAIM_ROLE_MEDIATOR.
+*/
+func (c *Claims) SendMessage(claimId int64, receiverRole string, message string) error {
+
+    if strings.TrimSpace(message) == "" {
+        return errors.New("The message is empty.")
+    }
+
+    if receiverRole != CLAIM_ROLE_COMPLAINANT && receiverRole != CLAIM_ROLE_MEDIATOR {
+        return errors.New("Messages may only be sent to the complainant or the mediator.")
+    }
+
+    body := struct {
+        ReceiverRole string `json:"receiver_role"`
+        Message      string `json:"message"`
+    }{ReceiverRole: receiverRole, Message: message}
+
+    return c.client.NewRequest(http.MethodPost, claimPath(claimId)+"/actions/send-message").JSON(body).Decode(nil)
+}
+
+func (c *Claims) Evidences(claimId int64) ([]ClaimEvidence, error) {
+
+    var evidences []ClaimEvidence
+    err := c.client.NewRequest(http.MethodGet, claimPath(claimId)+"/evidences").Decode(&evidences)
+
+    return evidences, err
+}
+
+//Returns the returns of the claim, when the buyer was asked to send the product back.
+func (c *Claims) Returns(claimId int64) ([]ClaimReturn, error) {
+
+    var returns []ClaimReturn
+    err := c.client.NewRequest(http.MethodGet, "/post-purchase/v2/claims/"+strconv.FormatInt(claimId, 10)+"/returns").Decode(&returns)
+
+    return returns, err
+}
+
+type ClaimFunc func(claim *Claim, notification Notification, err error)
+
+/*
+This method registers handler for the notifications of the claims topic. The claim in the resource is fetched
+before calling handler; err tells whether it could not be fetched.
+*/
+func (n *Notifications) HandleClaims(handler ClaimFunc) {
+
+    n.Handle(TOPIC_CLAIMS, func(notification Notification) {
+
+        id, err := strconv.ParseInt(notification.Resource[strings.LastIndex(notification.Resource, "/")+1:], 10, 64)
+
+        if err != nil {
+            handler(nil, notification, errors.New("The resource "+notification.Resource+" is not a claim."))
+            return
+        }
+
+        claim, err := n.client.Claims().Get(id)
+        handler(claim, notification, err)
+    })
+}
@@ -0,0 +1,146 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "time"
+)
+
+const testClaim = "{\"id\":5000,\"resource_id\":2000,\"resource\":\"order\",\"status\":\"opened\",\"stage\":\"claim\",\"players\":[" +
+    "{\"role\":\"complainant\",\"user_id\":1},{\"role\":\"respondent\",\"user_id\":2,\"available_actions\":[" +
+    "{\"action\":\"send_message_to_complainant\",\"mandatory\":true,\"due_date\":\"2016-06-23T10:00:00.000-04:00\"}," +
+    "{\"action\":\"refund\",\"mandatory\":true,\"due_date\":\"2016-06-22T10:00:00.000-04:00\"}," +
+    "{\"action\":\"open_dispute\",\"mandatory\":false,\"due_date\":\"2016-06-21T10:00:00.000-04:00\"}]}]}"
+
+func Test_claim_deadline_is_the_earliest_mandatory_action_of_the_respondent(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path != "/post-purchase/v1/claims/5000" {
+            w.WriteHeader(http.StatusNotFound)
+            return
+        }
+        w.Write([]byte(testClaim))
+    })
+    defer server.Close()
+
+    claim, err := client.Claims().Get(5000)
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    deadline, ok := claim.Deadline(CLAIM_ROLE_RESPONDENT)
+    expected, _ := time.Parse(time.RFC3339, "2016-06-22T10:00:00-04:00")
+
+    if !ok || !deadline.Equal(expected) {
+        log.Printf("unexpected deadline %v %v\n", deadline, ok)
+        t.FailNow()
+    }
+
+    if left, ok := claim.TimeLeft(expected.Add(-time.Hour)); !ok || left != time.Hour {
+        log.Printf("unexpected time left %v\n", left)
+        t.FailNow()
+    }
+
+    if _, ok := claim.Deadline(CLAIM_ROLE_COMPLAINANT); ok {
+        log.Printf("the complainant has nothing pending\n")
+        t.FailNow()
+    }
+}
+
+func Test_claims_are_searched_by_status_and_stage(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        query := r.URL.Query()
+        if r.URL.Path != "/post-purchase/v1/claims/search" || query.Get("status") != "opened" || query.Get("stage") != "dispute" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        w.Write([]byte("{\"paging\":{\"total\":1,\"offset\":0,\"limit\":30},\"data\":[" + testClaim + "]}"))
+    })
+    defer server.Close()
+
+    var page ClaimSearch
+
+    if err := client.Claims().Search(CLAIM_OPENED, CLAIM_STAGE_DISPUTE, 30).Next(&page); err != nil || len(page.Data) != 1 || page.Data[0].Id != 5000 {
+        log.Printf("unexpected claims %v %v\n", page, err)
+        t.FailNow()
+    }
+}
+
+func Test_claims_notifications_arrive_as_typed_claims(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte(testClaim))
+    })
+    defer server.Close()
+
+    claims := make(chan *Claim, 1)
+    notifications := client.Notifications()
+    notifications.HandleClaims(func(claim *Claim, notification Notification, err error) {
+        if err != nil {
+            log.Printf("Error: %s\n", err)
+        }
+        claims <- claim
+    })
+
+    recorder := httptest.NewRecorder()
+    request := httptest.NewRequest(http.MethodPost, "/notifications", strings.NewReader("{\"resource\":\"/post-purchase/v1/claims/5000\",\"user_id\":2,\"topic\":\"claims\"}"))
+    notifications.ServeHTTP(recorder, request)
+
+    if recorder.Code != http.StatusOK {
+        log.Printf("notifications should be acknowledged, got %d\n", recorder.Code)
+        t.FailNow()
+    }
+
+    select {
+    case claim := <-claims:
+        if claim == nil || claim.Id != 5000 || claim.Player(CLAIM_ROLE_RESPONDENT).UserId != 2 {
+            log.Printf("unexpected claim %v\n", claim)
+            t.FailNow()
+        }
+    case <-time.After(time.Second):
+        log.Printf("the claim never arrived\n")
+        t.FailNow()
+    }
+}
+
+func Test_claim_messages_are_only_sent_to_the_buyer_or_the_mediator(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost || r.URL.Path != "/post-purchase/v1/claims/5000/actions/send-message" {
+            w.WriteHeader(http.StatusBadRequest)
+        }
+    })
+    defer server.Close()
+
+    if err := client.Claims().SendMessage(5000, CLAIM_ROLE_COMPLAINANT, "We will refund you today"); err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    if err := client.Claims().SendMessage(5000, CLAIM_ROLE_RESPONDENT, "hi"); err == nil {
+        log.Printf("the seller may not send messages to itself\n")
+        t.FailNow()
+    }
+}
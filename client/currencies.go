@@ -0,0 +1,170 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "math/big"
+    "net/http"
+    "strings"
+    "time"
+)
+
+const DEFAULT_CURRENCIES_TTL = 24 * time.Hour
+
+//Currencies rarely change, so they are shared by every client.
+var CurrenciesCache = NewResponseCache(DEFAULT_CURRENCIES_TTL)
+
+//Currencies written with a point as decimal separator and a comma for the thousands; the rest use the opposite.
+var pointDecimalCurrencies = map[string]bool{"USD": true, "MXN": true, "DOP": true, "PAB": true}
+
+type Currency struct {
+    Id            string `json:"id"`
+    Symbol        string `json:"symbol"`
+    Description   string `json:"description"`
+    DecimalPlaces int    `json:"decimal_places"`
+}
+
+//Rounds the amount to the decimal places of the currency.
+func (c Currency) Round(amount Money) Money {
+    return amount.Round(c.DecimalPlaces)
+}
+
+/*
+This method formats the amount the way it is shown in the site, for instance $ 1.234,50 for ARS or $ 1,234.50 for MXN.
+*/
+func (c Currency) Format(amount Money) string {
+
+    decimal, thousands := ",", "."
+    if pointDecimalCurrencies[c.Id] {
+        decimal, thousands = ".", ","
+    }
+
+    number := amount.StringFixed(c.DecimalPlaces)
+    sign := ""
+
+    if strings.HasPrefix(number, "-") {
+        sign, number = "-", number[1:]
+    }
+
+    parts := strings.SplitN(number, ".", 2)
+    integer := parts[0]
+
+    var grouped strings.Builder
+    for i, digit := range integer {
+        if i > 0 && (len(integer)-i)%3 == 0 {
+            grouped.WriteString(thousands)
+        }
+        grouped.WriteRune(digit)
+    }
+
+    if len(parts) == 2 {
+        grouped.WriteString(decimal + parts[1])
+    }
+
+    symbol := c.Symbol
+    if symbol == "" {
+        symbol = c.Id
+    }
+
+    return sign + symbol + " " + grouped.String()
+}
+
+/*
+CurrencyConversion is the exchange rate between two currencies. Ratio is the amount of To units for each From unit.
+Ratios such as ARS to USD need more decimals than a Money keeps, so they are float64.
+*/
+type CurrencyConversion struct {
+    Ratio            float64 `json:"ratio"`
+    MercadoPagoRatio float64 `json:"mercado_pago_ratio"`
+}
+
+/*
+Currencies gives typed access to the currencies and their exchange rates.
+*/
+type Currencies struct {
+    client *Client
+}
+
+func (client *Client) Currencies() *Currencies {
+    return &Currencies{client: client}
+}
+
+func (c *Currencies) List() ([]Currency, error) {
+
+    var currencies []Currency
+    err := c.client.getCached(CurrenciesCache, "/currencies", &currencies)
+
+    return currencies, err
+}
+
+func (c *Currencies) Get(currencyId string) (*Currency, error) {
+
+    currency := new(Currency)
+
+    if err := c.client.getCached(CurrenciesCache, "/currencies/"+currencyId, currency); err != nil {
+        return nil, err
+    }
+
+    return currency, nil
+}
+
+//Returns the current exchange rate. Rates change along the day, so they are not cached.
+func (c *Currencies) Conversion(from string, to string) (*CurrencyConversion, error) {
+
+    conversion := new(CurrencyConversion)
+
+    err := c.client.NewRequest(http.MethodGet, "/currency_conversions/search").
+        Query("from", from).
+        Query("to", to).
+        Decode(conversion)
+
+    if err != nil {
+        return nil, err
+    }
+
+    if conversion.Ratio <= 0 {
+        return nil, errors.New("There is no exchange rate from " + from + " to " + to + ".")
+    }
+
+    return conversion, nil
+}
+
+/*
+This method converts the amount with the current exchange rate. The product is computed exactly and rounded once,
+to the decimal places of the target currency.
+*/
+func (c *Currencies) Convert(amount Money, from string, to string) (Money, error) {
+
+    if from == to {
+        return amount, nil
+    }
+
+    conversion, err := c.Conversion(from, to)
+
+    if err != nil {
+        return 0, err
+    }
+
+    currency, err := c.Get(to)
+
+    if err != nil {
+        return 0, err
+    }
+
+    return amount.mulRat(new(big.Rat).SetFloat64(conversion.Ratio), currency.DecimalPlaces)
+}
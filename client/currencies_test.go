@@ -0,0 +1,96 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+)
+
+func Test_amounts_are_formatted_per_currency(t *testing.T) {
+
+    amount, _ := ParseMoney("-1234567.891")
+
+    ars := Currency{Id: "ARS", Symbol: "$", DecimalPlaces: 2}
+    mxn := Currency{Id: "MXN", Symbol: "$", DecimalPlaces: 2}
+    clp := Currency{Id: "CLP", Symbol: "$", DecimalPlaces: 0}
+
+    if ars.Format(amount) != "-$ 1.234.567,89" || mxn.Format(amount) != "-$ 1,234,567.89" || clp.Format(amount) != "-$ 1.234.568" {
+        log.Printf("unexpected formats %s %s %s\n", ars.Format(amount), mxn.Format(amount), clp.Format(amount))
+        t.FailNow()
+    }
+}
+
+func Test_amounts_are_converted_and_rounded_to_the_target_currency(t *testing.T) {
+
+    CurrenciesCache.Clear()
+    defer CurrenciesCache.Clear()
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/currency_conversions/search":
+            if r.URL.Query().Get("from") != "USD" || r.URL.Query().Get("to") != "CLP" {
+                w.WriteHeader(http.StatusBadRequest)
+                return
+            }
+            w.Write([]byte("{\"ratio\":675.123,\"mercado_pago_ratio\":680}"))
+        case "/currencies/CLP":
+            w.Write([]byte("{\"id\":\"CLP\",\"symbol\":\"$\",\"description\":\"Peso Chileno\",\"decimal_places\":0}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    converted, err := client.Currencies().Convert(MoneyFromFloat(10.5), "USD", "CLP")
+
+    if err != nil || converted.String() != "7089" {
+        log.Printf("unexpected conversion %s %v\n", converted, err)
+        t.FailNow()
+    }
+
+    if same, err := client.Currencies().Convert(MoneyFromInt(3), "CLP", "CLP"); err != nil || same != MoneyFromInt(3) {
+        log.Printf("converting to the same currency should not change the amount %s %v\n", same, err)
+        t.FailNow()
+    }
+}
+
+func Test_small_exchange_rates_keep_their_precision(t *testing.T) {
+
+    CurrenciesCache.Clear()
+    defer CurrenciesCache.Clear()
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/currency_conversions/search":
+            w.Write([]byte("{\"ratio\":0.00083312}"))
+        case "/currencies/USD":
+            w.Write([]byte("{\"id\":\"USD\",\"symbol\":\"U$S\",\"decimal_places\":2}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    converted, err := client.Currencies().Convert(MoneyFromInt(1000000), "ARS", "USD")
+
+    if err != nil || converted.String() != "833.12" {
+        log.Printf("unexpected conversion %s %v\n", converted, err)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,134 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "html"
+    "net/http"
+    "regexp"
+    "strings"
+    "unicode"
+)
+
+const MAX_DESCRIPTION_LENGTH = 50000
+
+var (
+    lineBreakTags  = regexp.MustCompile(`(?i)<\s*(br|/p|/div|/li|/h[1-6])\s*/?>`)
+    htmlTags       = regexp.MustCompile(`<[^>]*>`)
+    manyBlankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+type Description struct {
+    Text        string `json:"text,omitempty"`
+    PlainText   string `json:"plain_text"`
+    LastUpdated string `json:"last_updated,omitempty"`
+    DateCreated string `json:"date_created,omitempty"`
+}
+
+/*
+Descriptions gives typed access to the description of the items, which is a resource on its own.
+*/
+type Descriptions struct {
+    client *Client
+}
+
+func (client *Client) Descriptions() *Descriptions {
+    return &Descriptions{client: client}
+}
+
+func (d *Descriptions) Get(itemId string) (*Description, error) {
+
+    description := new(Description)
+
+    if err := d.client.NewRequest(http.MethodGet, "/items/"+itemId+"/description").Decode(description); err != nil {
+        return nil, err
+    }
+
+    return description, nil
+}
+
+/*
+This method creates the description of an item which does not have one. The text is sanitized first.
+*/
+func (d *Descriptions) Set(itemId string, text string) (*Description, error) {
+    return d.send(http.MethodPost, itemId, text)
+}
+
+/*
+This method replaces the description of an item. The text is sanitized first.
+*/
+func (d *Descriptions) Update(itemId string, text string) (*Description, error) {
+    return d.send(http.MethodPut, itemId, text)
+}
+
+func (d *Descriptions) send(method string, itemId string, text string) (*Description, error) {
+
+    body := Description{PlainText: SanitizeDescription(text)}
+    description := new(Description)
+
+    if err := d.client.NewRequest(method, "/items/"+itemId+"/description").JSON(body).Decode(description); err != nil {
+        return nil, err
+    }
+
+    return description, nil
+}
+
+/*
+This function turns the text into a valid plain text description: HTML tags are removed (line breaking ones are
+replaced by a new line), entities are unescaped, control characters and characters which are not accepted by ML
+(for instance emojis) are removed together with the extra spaces they leave, blank lines are collapsed and the text is cut at MAX_DESCRIPTION_LENGTH.
+*/
+func SanitizeDescription(text string) string {
+
+    text = strings.Replace(text, "\r\n", "\n", -1)
+    text = strings.Replace(text, "\r", "\n", -1)
+    text = lineBreakTags.ReplaceAllString(text, "\n")
+    text = htmlTags.ReplaceAllString(text, "")
+    text = html.UnescapeString(text)
+
+    //The spaces around a removed character would be left doubled, so the ones after it are dropped.
+    var sanitized strings.Builder
+    removed := false
+
+    for _, r := range text {
+        if r != '\n' && r != '\t' && (unicode.IsControl(r) || r > 0xFFFF) {
+            removed = true
+            continue
+        }
+        if removed && r == ' ' && strings.HasSuffix(sanitized.String(), " ") {
+            continue
+        }
+        removed = removed && r == ' '
+        sanitized.WriteRune(r)
+    }
+
+    text = sanitized.String()
+
+    lines := strings.Split(text, "\n")
+    for i, line := range lines {
+        lines[i] = strings.TrimRight(line, " \t")
+    }
+
+    text = manyBlankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+    text = strings.TrimSpace(text)
+
+    if runes := []rune(text); len(runes) > MAX_DESCRIPTION_LENGTH {
+        text = string(runes[:MAX_DESCRIPTION_LENGTH])
+    }
+
+    return text
+}
@@ -0,0 +1,83 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "encoding/json"
+    "io/ioutil"
+    "strings"
+)
+
+func Test_description_is_sanitized_as_plain_text(t *testing.T) {
+
+    text := "<p>Ray-Ban WAYFARER</p><p>Model: RB2140 &amp; case</p>\r\n\r\n\r\n\r\nColor \U0001F576 black\nNew in Box \U0001F60E\x07  "
+    expected := "Ray-Ban WAYFARER\nModel: RB2140 & case\n\nColor black\nNew in Box"
+
+    if sanitized := SanitizeDescription(text); sanitized != expected {
+        log.Printf("description was not properly sanitized\n expected: %q\n obtained: %q\n", expected, sanitized)
+        t.FailNow()
+    }
+}
+
+func Test_description_is_cut_at_its_max_length(t *testing.T) {
+
+    text := strings.Repeat("a", MAX_DESCRIPTION_LENGTH + 10)
+
+    if len(SanitizeDescription(text)) != MAX_DESCRIPTION_LENGTH {
+        t.FailNow()
+    }
+}
+
+func Test_creating_an_item_with_description_creates_the_description_resource(t *testing.T) {
+
+    var itemBody map[string]interface{}
+    var description Description
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch {
+        case r.Method == http.MethodPost && r.URL.Path == "/items":
+            json.NewDecoder(r.Body).Decode(&itemBody)
+            w.WriteHeader(http.StatusCreated)
+            w.Write([]byte("{\"id\":\"MLA123\"}"))
+        case r.Method == http.MethodPost && r.URL.Path == "/items/MLA123/description":
+            content, _ := ioutil.ReadAll(r.Body)
+            json.Unmarshal(content, &description)
+            w.WriteHeader(http.StatusCreated)
+            w.Write(content)
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    item := &Item{Title:"Ray-Ban", CategoryId:"MLA1912", Description:"<b>New</b> in box"}
+
+    created, err := client.Items().Create(item)
+
+    if err != nil || created.Description != "New in box" {
+        log.Printf("unexpected item %v %v\n", created, err)
+        t.FailNow()
+    }
+
+    if _, inline := itemBody["description"]; inline || description.PlainText != "New in box" {
+        log.Printf("the description should be sent to its own resource. item: %v description: %v\n", itemBody, description)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,216 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "strconv"
+    "strings"
+    "unicode/utf8"
+)
+
+const (
+    RATING_POSITIVE = "positive"
+    RATING_NEUTRAL  = "neutral"
+    RATING_NEGATIVE = "negative"
+
+    MAX_FEEDBACK_LENGTH = 160
+    MAX_REPLY_LENGTH    = 160
+)
+
+type Feedback struct {
+    Id          int64  `json:"id"`
+    Role        string `json:"role"`
+    Fulfilled   bool   `json:"fulfilled"`
+    Rating      string `json:"rating"`
+    Message     string `json:"message"`
+    Reply       string `json:"reply"`
+    Status      string `json:"status"`
+    Modified    bool   `json:"modified"`
+    DateCreated string `json:"date_created"`
+    From        struct {
+        Id int64 `json:"id"`
+    } `json:"from"`
+    To struct {
+        Id int64 `json:"id"`
+    } `json:"to"`
+}
+
+/*
+OrderFeedback has the feedback left by both parts of an order. Sale is the one left by the seller and
+Purchase the one left by the buyer; they are nil until given.
+*/
+type OrderFeedback struct {
+    Sale     *Feedback `json:"sale"`
+    Purchase *Feedback `json:"purchase"`
+}
+
+type Review struct {
+    Id          int64  `json:"id"`
+    ReviewerId  int64  `json:"reviewer_id"`
+    Title       string `json:"title"`
+    Content     string `json:"content"`
+    Rate        int    `json:"rate"`
+    Likes       int    `json:"likes"`
+    Dislikes    int    `json:"dislikes"`
+    Status      string `json:"status"`
+    DateCreated string `json:"date_created"`
+}
+
+type RatingLevels struct {
+    OneStar   int `json:"one_star"`
+    TwoStar   int `json:"two_star"`
+    ThreeStar int `json:"three_star"`
+    FourStar  int `json:"four_star"`
+    FiveStar  int `json:"five_star"`
+}
+
+//Returns the amount of reviews rated with the given stars, from 1 to 5.
+func (r RatingLevels) Count(stars int) int {
+
+    switch stars {
+    case 1:
+        return r.OneStar
+    case 2:
+        return r.TwoStar
+    case 3:
+        return r.ThreeStar
+    case 4:
+        return r.FourStar
+    case 5:
+        return r.FiveStar
+    }
+
+    return 0
+}
+
+func (r RatingLevels) Total() int {
+    return r.OneStar + r.TwoStar + r.ThreeStar + r.FourStar + r.FiveStar
+}
+
+//Returns the average of the ratings, or 0 when there are none.
+func (r RatingLevels) Average() float64 {
+
+    total := r.Total()
+
+    if total == 0 {
+        return 0
+    }
+
+    sum := 0
+    for stars := 1; stars <= 5; stars++ {
+        sum += stars * r.Count(stars)
+    }
+
+    return float64(sum) / float64(total)
+}
+
+/*
+ItemReviews is a page of the reviews of an item, together with the aggregates of all of them.
+*/
+type ItemReviews struct {
+    Paging        Paging       `json:"paging"`
+    Reviews       []Review     `json:"reviews"`
+    RatingAverage float64      `json:"rating_average"`
+    RatingLevels  RatingLevels `json:"rating_levels"`
+}
+
+/*
+Feedbacks gives typed access to the feedback of orders and the reviews of items.
+*/
+type Feedbacks struct {
+    client *Client
+}
+
+func (client *Client) Feedbacks() *Feedbacks {
+    return &Feedbacks{client: client}
+}
+
+func orderFeedbackPath(orderId int64) string {
+    return "/orders/" + strconv.FormatInt(orderId, 10) + "/feedback"
+}
+
+func (f *Feedbacks) Order(orderId int64) (*OrderFeedback, error) {
+
+    feedback := new(OrderFeedback)
+
+    if err := f.client.NewRequest(http.MethodGet, orderFeedbackPath(orderId)).Decode(feedback); err != nil {
+        return nil, err
+    }
+
+    return feedback, nil
+}
+
+/*
+This method leaves the feedback of the seller for the buyer of the order. rating is RATING_POSITIVE,
+RATING_NEUTRAL or RATING_NEGATIVE, and fulfilled tells whether the sale was completed.
+*/
+func (f *Feedbacks) Give(orderId int64, fulfilled bool, rating string, message string) (*Feedback, error) {
+
+    if rating != RATING_POSITIVE && rating != RATING_NEUTRAL && rating != RATING_NEGATIVE {
+        return nil, fmt.Errorf("Unknown rating %s.", rating)
+    }
+
+    if strings.TrimSpace(message) == "" {
+        return nil, errors.New("The message of the feedback is empty.")
+    }
+
+    if utf8.RuneCountInString(message) > MAX_FEEDBACK_LENGTH {
+        return nil, fmt.Errorf("The message of the feedback may have at most %d characters.", MAX_FEEDBACK_LENGTH)
+    }
+
+    body := struct {
+        Fulfilled bool   `json:"fulfilled"`
+        Rating    string `json:"rating"`
+        Message   string `json:"message"`
+    }{Fulfilled: fulfilled, Rating: rating, Message: message}
+
+    feedback := new(Feedback)
+
+    if err := f.client.NewRequest(http.MethodPost, orderFeedbackPath(orderId)).JSON(body).Decode(feedback); err != nil {
+        return nil, err
+    }
+
+    return feedback, nil
+}
+
+//Replies the feedback left by the buyer. Each feedback may be replied only once.
+func (f *Feedbacks) Reply(feedbackId int64, text string) error {
+
+    if strings.TrimSpace(text) == "" {
+        return errors.New("The reply is empty.")
+    }
+
+    if utf8.RuneCountInString(text) > MAX_REPLY_LENGTH {
+        return fmt.Errorf("The reply may have at most %d characters.", MAX_REPLY_LENGTH)
+    }
+
+    body := struct {
+        Reply string `json:"reply"`
+    }{Reply: text}
+
+    return f.client.NewRequest(http.MethodPost, "/feedback/"+strconv.FormatInt(feedbackId, 10)+"/reply").JSON(body).Decode(nil)
+}
+
+/*
+This method returns a Pager over the product reviews of the item. Each page is decoded into ItemReviews.
+*/
+func (f *Feedbacks) Reviews(itemId string, limit int) *Pager {
+    return f.client.NewPager("/reviews/item/"+itemId, nil, limit)
+}
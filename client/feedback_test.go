@@ -0,0 +1,99 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "encoding/json"
+    "fmt"
+)
+
+func Test_seller_feedback_is_checked_before_posting_it(t *testing.T) {
+
+    var received map[string]interface{}
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch {
+        case r.Method == http.MethodGet && r.URL.Path == "/orders/2000/feedback":
+            w.Write([]byte("{\"sale\":null,\"purchase\":{\"id\":7,\"rating\":\"negative\",\"message\":\"late\"}}"))
+        case r.Method == http.MethodPost && r.URL.Path == "/orders/2000/feedback":
+            json.NewDecoder(r.Body).Decode(&received)
+            w.Write([]byte("{\"id\":8,\"rating\":\"positive\"}"))
+        case r.Method == http.MethodPost && r.URL.Path == "/feedback/7/reply":
+            json.NewDecoder(r.Body).Decode(&received)
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    feedback, err := client.Feedbacks().Order(2000)
+
+    if err != nil || feedback.Sale != nil || feedback.Purchase.Rating != RATING_NEGATIVE {
+        log.Printf("unexpected feedback %v %v\n", feedback, err)
+        t.FailNow()
+    }
+
+    if _, err := client.Feedbacks().Give(2000, true, "excellent", "Thanks"); err == nil {
+        log.Printf("unknown ratings should fail\n")
+        t.FailNow()
+    }
+
+    given, err := client.Feedbacks().Give(2000, true, RATING_POSITIVE, "Thanks for your purchase")
+
+    if err != nil || given.Id != 8 || received["fulfilled"] != true || received["rating"] != "positive" {
+        log.Printf("unexpected feedback %v %v %v\n", given, received, err)
+        t.FailNow()
+    }
+
+    if err := client.Feedbacks().Reply(7, "It was shipped on time"); err != nil || received["reply"] != "It was shipped on time" {
+        log.Printf("unexpected reply %v %v\n", received, err)
+        t.FailNow()
+    }
+}
+
+func Test_item_reviews_are_paginated_with_their_aggregates(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path != "/reviews/item/MLA123" {
+            w.WriteHeader(http.StatusNotFound)
+            return
+        }
+        offset := r.URL.Query().Get("offset")
+        fmt.Fprintf(w, "{\"paging\":{\"total\":2,\"offset\":%s,\"limit\":1},\"reviews\":[{\"id\":1%s,\"rate\":5}],"+
+            "\"rating_average\":4.5,\"rating_levels\":{\"four_star\":1,\"five_star\":1}}", offset, offset)
+    })
+    defer server.Close()
+
+    pager := client.Feedbacks().Reviews("MLA123", 1)
+    var reviews []Review
+    var page ItemReviews
+
+    for pager.HasNext() {
+        if err := pager.Next(&page); err != nil {
+            log.Printf("Error: %s\n", err)
+            t.FailNow()
+        }
+        reviews = append(reviews, page.Reviews...)
+    }
+
+    if len(reviews) != 2 || page.RatingLevels.Total() != 2 || page.RatingLevels.Average() != 4.5 || page.RatingLevels.Count(4) != 1 {
+        log.Printf("unexpected reviews %v %v\n", reviews, page)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,165 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "log"
+    "net/http"
+)
+
+type Item struct {
+    Id                string          `json:"id,omitempty"`
+    SiteId            string          `json:"site_id,omitempty"`
+    SellerId          int64           `json:"seller_id,omitempty"`
+    Title             string          `json:"title,omitempty"`
+    CategoryId        string          `json:"category_id,omitempty"`
+    Price             Money           `json:"price,omitempty"`
+    CurrencyId        string          `json:"currency_id,omitempty"`
+    AvailableQuantity int             `json:"available_quantity,omitempty"`
+    SoldQuantity      int             `json:"sold_quantity,omitempty"`
+    BuyingMode        string          `json:"buying_mode,omitempty"`
+    ListingTypeId     string          `json:"listing_type_id,omitempty"`
+    Condition         string          `json:"condition,omitempty"`
+    VideoId           string          `json:"video_id,omitempty"`
+    Warranty          string          `json:"warranty,omitempty"`
+    Pictures          []ItemPicture   `json:"pictures,omitempty"`
+    Attributes        []ItemAttribute `json:"attributes,omitempty"`
+    Variations        []Variation     `json:"variations,omitempty"`
+    Status            string          `json:"status,omitempty"`
+    Permalink         string          `json:"permalink,omitempty"`
+    CatalogProductId  string          `json:"catalog_product_id,omitempty"`
+    CatalogListing    bool            `json:"catalog_listing,omitempty"`
+    InventoryId       string          `json:"inventory_id,omitempty"`
+    UserProductId     string          `json:"user_product_id,omitempty"`
+    Description       string          `json:"-"`
+}
+
+/*
+When creating an item, pictures may be referenced by their Source URL or by the Id returned by UploadPicture.
+*/
+type ItemPicture struct {
+    Id     string `json:"id,omitempty"`
+    Source string `json:"source,omitempty"`
+    Url    string `json:"url,omitempty"`
+}
+
+type ItemAttribute struct {
+    Id        string `json:"id"`
+    Name      string `json:"name,omitempty"`
+    ValueId   string `json:"value_id,omitempty"`
+    ValueName string `json:"value_name,omitempty"`
+}
+
+//Returns the attribute with the given id, or nil when the item does not have it.
+func (item *Item) Attribute(id string) *ItemAttribute {
+
+    for i := range item.Attributes {
+        if item.Attributes[i].Id == id {
+            return &item.Attributes[i]
+        }
+    }
+
+    return nil
+}
+
+/*
+Items gives typed access to the items API.
+*/
+type Items struct {
+    client *Client
+}
+
+func (client *Client) Items() *Items {
+    return &Items{client: client}
+}
+
+func (i *Items) Get(itemId string) (*Item, error) {
+
+    item := new(Item)
+
+    if err := i.client.NewRequest(http.MethodGet, "/items/"+itemId).Decode(item); err != nil {
+        return nil, err
+    }
+
+    return item, nil
+}
+
+/*
+This method lists a new item and returns it as created by ML.
+When the item has no category, it is predicted from the title (see Categories.Predict) and the best candidate is used.
+The description is not part of the item resource, so when the item has one it is created afterwards. If that fails,
+both the created item and the error are returned. The given item is not changed.
+*/
+func (i *Items) Create(item *Item) (*Item, error) {
+
+    listing := *item
+    listing.Attributes = append([]ItemAttribute(nil), item.Attributes...)
+    listing.Pictures = append([]ItemPicture(nil), item.Pictures...)
+
+    if item.CategoryId == "" {
+
+        if item.SiteId == "" || item.Title == "" {
+            return nil, errors.New("Either the category or the site and the title are needed for listing an item.")
+        }
+
+        candidates, err := i.client.Categories().Predict(item.SiteId, item.Title, 1)
+
+        if err != nil {
+            log.Printf("Error while predicting the category of %s: %s\n", item.Title, err)
+            return nil, err
+        }
+
+        if len(candidates) == 0 {
+            return nil, errors.New("No category was found for " + item.Title)
+        }
+
+        candidates[0].ApplyTo(&listing)
+    }
+
+    created := new(Item)
+
+    if err := i.client.NewRequest(http.MethodPost, "/items").JSON(&listing).Decode(created); err != nil {
+        return nil, err
+    }
+
+    if item.Description != "" {
+        description, err := i.client.Descriptions().Set(created.Id, item.Description)
+
+        if err != nil {
+            log.Printf("Error while creating the description of item %s: %s\n", created.Id, err)
+            return created, err
+        }
+        created.Description = description.PlainText
+    }
+
+    return created, nil
+}
+
+/*
+This method applies the changes to the item. changes may be a partial Item or any value that can be encoded as JSON.
+*/
+func (i *Items) Update(itemId string, changes interface{}) (*Item, error) {
+
+    updated := new(Item)
+
+    if err := i.client.NewRequest(http.MethodPut, "/items/"+itemId).JSON(changes).Decode(updated); err != nil {
+        return nil, err
+    }
+
+    return updated, nil
+}
@@ -0,0 +1,244 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "net/url"
+    "time"
+)
+
+const (
+    LISTING_FREE         = "free"
+    LISTING_BRONZE       = "bronze"
+    LISTING_SILVER       = "silver"
+    LISTING_GOLD         = "gold"
+    LISTING_GOLD_SPECIAL = "gold_special"
+    LISTING_GOLD_PREMIUM = "gold_premium"
+    LISTING_GOLD_PRO     = "gold_pro"
+
+    DEFAULT_LISTING_TYPES_TTL = 24 * time.Hour
+)
+
+//The listing types of the sites rarely change, so they are shared by every client.
+var ListingTypesCache = NewResponseCache(DEFAULT_LISTING_TYPES_TTL)
+
+//Returned by ChangeListingType when a change which costs money was not confirmed.
+var ErrChangeNotConfirmed = errors.New("The change of listing type costs money and was not confirmed.")
+
+type ListingType struct {
+    SiteId string `json:"site_id"`
+    Id     string `json:"id"`
+    Name   string `json:"name"`
+}
+
+/*
+ListingPrice is what listing an item with a listing type costs: ListingFeeAmount is paid when listing it,
+and SaleFeeAmount for each sale.
+*/
+type ListingPrice struct {
+    ListingTypeId    string `json:"listing_type_id"`
+    ListingTypeName  string `json:"listing_type_name"`
+    ListingExposure  string `json:"listing_exposure"`
+    CurrencyId       string `json:"currency_id"`
+    ListingFeeAmount Money  `json:"listing_fee_amount"`
+    SaleFeeAmount    Money  `json:"sale_fee_amount"`
+}
+
+/*
+ListingTypeChange describes the change of the listing type of an item, with the fees before and after it.
+*/
+type ListingTypeChange struct {
+    ItemId  string
+    Current ListingPrice
+    Target  ListingPrice
+}
+
+//Tells whether the change costs money: the new listing type has a listing fee or a higher sale fee.
+func (c *ListingTypeChange) CostsMoney() bool {
+    return c.Target.ListingFeeAmount > 0 || c.Target.SaleFeeAmount > c.Current.SaleFeeAmount
+}
+
+//Returns a message describing the fees of the change, or an empty string when it costs nothing.
+func (c *ListingTypeChange) Warning() string {
+
+    if !c.CostsMoney() {
+        return ""
+    }
+
+    return fmt.Sprintf("Changing item %s from %s to %s costs %s %s for listing it, and the sale fee goes from %s to %s %s.",
+        c.ItemId, c.Current.ListingTypeId, c.Target.ListingTypeId, c.Target.ListingFeeAmount, c.Target.CurrencyId,
+        c.Current.SaleFeeAmount, c.Target.SaleFeeAmount, c.Target.CurrencyId)
+}
+
+/*
+ConfirmFunc is asked before applying a change which costs money; the change is applied only when it returns true.
+*/
+type ConfirmFunc func(change *ListingTypeChange) bool
+
+/*
+ListingTypes gives typed access to the listing types, their fees and the upgrades of the items.
+*/
+type ListingTypes struct {
+    client *Client
+}
+
+func (client *Client) ListingTypes() *ListingTypes {
+    return &ListingTypes{client: client}
+}
+
+func (l *ListingTypes) ForSite(siteId string) ([]ListingType, error) {
+
+    var types []ListingType
+    err := l.client.getCached(ListingTypesCache, "/sites/"+siteId+"/listing_types", &types)
+
+    return types, err
+}
+
+//Returns the listing types the item may be changed to.
+func (l *ListingTypes) ForItem(itemId string) ([]ListingType, error) {
+
+    var available struct {
+        Available []ListingType `json:"available"`
+    }
+
+    err := l.client.NewRequest(http.MethodGet, "/items/"+itemId+"/available_listing_types").Decode(&available)
+
+    return available.Available, err
+}
+
+/*
+This method returns the fees of every listing type of the site for an item with the given price and category.
+*/
+func (l *ListingTypes) Prices(siteId string, price Money, categoryId string) ([]ListingPrice, error) {
+
+    params := url.Values{}
+    params.Set("price", price.String())
+
+    if categoryId != "" {
+        params.Set("category_id", categoryId)
+    }
+
+    var prices []ListingPrice
+    err := l.client.NewRequest(http.MethodGet, "/sites/"+siteId+"/listing_prices").Params(params).Decode(&prices)
+
+    return prices, err
+}
+
+//Returns the fees of a single listing type.
+func (l *ListingTypes) Price(siteId string, listingTypeId string, price Money, categoryId string) (*ListingPrice, error) {
+
+    prices, err := l.Prices(siteId, price, categoryId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    for i := range prices {
+        if prices[i].ListingTypeId == listingTypeId {
+            return &prices[i], nil
+        }
+    }
+
+    return nil, fmt.Errorf("The listing type %s does not exist in site %s.", listingTypeId, siteId)
+}
+
+/*
+This method describes what changing the listing type of the item would cost, without changing it.
+It fails when the item already has that listing type or may not be changed to it.
+*/
+func (l *ListingTypes) PlanChange(itemId string, listingTypeId string) (*ListingTypeChange, error) {
+
+    item, err := l.client.Items().Get(itemId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    if item.ListingTypeId == listingTypeId {
+        return nil, fmt.Errorf("Item %s already has listing type %s.", itemId, listingTypeId)
+    }
+
+    available, err := l.ForItem(itemId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    allowed := false
+    for _, listingType := range available {
+        allowed = allowed || listingType.Id == listingTypeId
+    }
+
+    if !allowed {
+        return nil, fmt.Errorf("Item %s may not be changed to listing type %s.", itemId, listingTypeId)
+    }
+
+    prices, err := l.Prices(item.SiteId, item.Price, item.CategoryId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    change := &ListingTypeChange{ItemId: itemId}
+    found := false
+
+    for _, price := range prices {
+        switch price.ListingTypeId {
+        case item.ListingTypeId:
+            change.Current = price
+        case listingTypeId:
+            change.Target = price
+            found = true
+        }
+    }
+
+    if !found {
+        return nil, fmt.Errorf("There are no fees for listing type %s in site %s.", listingTypeId, item.SiteId)
+    }
+
+    return change, nil
+}
+
+/*
+This method upgrades or downgrades the listing type of the item. When the change costs money, confirm is asked
+first; a nil confirm rejects every change which costs money, returning ErrChangeNotConfirmed.
+*/
+func (l *ListingTypes) ChangeListingType(itemId string, listingTypeId string, confirm ConfirmFunc) (*ListingTypeChange, error) {
+
+    change, err := l.PlanChange(itemId, listingTypeId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    if change.CostsMoney() && (confirm == nil || !confirm(change)) {
+        return change, ErrChangeNotConfirmed
+    }
+
+    body := struct {
+        Id string `json:"id"`
+    }{Id: listingTypeId}
+
+    if err := l.client.NewRequest(http.MethodPost, "/items/"+itemId+"/listing_type").JSON(body).Decode(nil); err != nil {
+        return change, err
+    }
+
+    return change, nil
+}
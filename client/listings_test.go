@@ -0,0 +1,104 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+)
+
+func newListingTypesTestServerClient(changed *string) (*Client, func()) {
+
+    ListingTypesCache.Clear()
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch {
+        case r.URL.Path == "/items/MLA123":
+            w.Write([]byte("{\"id\":\"MLA123\",\"site_id\":\"MLA\",\"category_id\":\"MLA1912\",\"price\":1000,\"listing_type_id\":\"gold_special\"}"))
+        case r.URL.Path == "/items/MLA123/available_listing_types":
+            w.Write([]byte("{\"available\":[{\"site_id\":\"MLA\",\"id\":\"gold_pro\"},{\"site_id\":\"MLA\",\"id\":\"free\"}]}"))
+        case r.URL.Path == "/sites/MLA/listing_prices":
+            if r.URL.Query().Get("price") != "1000" || r.URL.Query().Get("category_id") != "MLA1912" {
+                w.WriteHeader(http.StatusBadRequest)
+                return
+            }
+            w.Write([]byte("[{\"listing_type_id\":\"gold_pro\",\"currency_id\":\"ARS\",\"listing_fee_amount\":0,\"sale_fee_amount\":160.5}," +
+                "{\"listing_type_id\":\"gold_special\",\"currency_id\":\"ARS\",\"listing_fee_amount\":0,\"sale_fee_amount\":130}," +
+                "{\"listing_type_id\":\"free\",\"currency_id\":\"ARS\",\"listing_fee_amount\":0,\"sale_fee_amount\":0}]"))
+        case r.Method == http.MethodPost && r.URL.Path == "/items/MLA123/listing_type":
+            *changed = "yes"
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+
+    return client, server.Close
+}
+
+func Test_upgrades_which_cost_money_need_confirmation(t *testing.T) {
+
+    changed := ""
+    client, closeServer := newListingTypesTestServerClient(&changed)
+    defer closeServer()
+
+    change, err := client.ListingTypes().ChangeListingType("MLA123", LISTING_GOLD_PRO, nil)
+
+    if err != ErrChangeNotConfirmed || changed != "" || !change.CostsMoney() || change.Warning() == "" {
+        log.Printf("the upgrade should not be applied without confirmation %v %v %s\n", change, err, changed)
+        t.FailNow()
+    }
+
+    var asked *ListingTypeChange
+    change, err = client.ListingTypes().ChangeListingType("MLA123", LISTING_GOLD_PRO, func(c *ListingTypeChange) bool {
+        asked = c
+        return true
+    })
+
+    if err != nil || changed != "yes" || asked == nil || asked.Target.SaleFeeAmount != MoneyFromFloat(160.5) || asked.Current.SaleFeeAmount != MoneyFromInt(130) {
+        log.Printf("the confirmed upgrade should be applied %v %v\n", asked, err)
+        t.FailNow()
+    }
+}
+
+func Test_downgrades_without_fees_are_applied_without_asking(t *testing.T) {
+
+    changed := ""
+    client, closeServer := newListingTypesTestServerClient(&changed)
+    defer closeServer()
+
+    change, err := client.ListingTypes().ChangeListingType("MLA123", LISTING_FREE, func(c *ListingTypeChange) bool {
+        log.Printf("free changes should not be confirmed\n")
+        t.FailNow()
+        return false
+    })
+
+    if err != nil || changed != "yes" || change.CostsMoney() {
+        log.Printf("unexpected change %v %v\n", change, err)
+        t.FailNow()
+    }
+
+    if _, err := client.ListingTypes().PlanChange("MLA123", LISTING_BRONZE); err == nil {
+        log.Printf("listing types which are not available for the item should fail\n")
+        t.FailNow()
+    }
+
+    if _, err := client.ListingTypes().PlanChange("MLA123", LISTING_GOLD_SPECIAL); err == nil || err.Error() != "Item MLA123 already has listing type gold_special." {
+        log.Printf("the current listing type should fail clearly %v\n", err)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,209 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "crypto/rand"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "log"
+    "net"
+    "net/http"
+    "time"
+)
+
+const (
+    DEFAULT_LOOPBACK_ADDR    = "127.0.0.1:0"
+    DEFAULT_LOOPBACK_PATH    = "/callback"
+    DEFAULT_LOOPBACK_TIMEOUT = 5 * time.Minute
+)
+
+/*
+LoopbackOptions configures the temporary HTTP listener used by NewLoopbackClient.
+Addr and Path build the redirect URL, which has to be the same one configured in your application.
+Open is called with the URL the user has to visit; it usually opens a browser. When nil, the URL is logged.
+ApiUrl replaces API_URL for exchanging the code, for instance with a mock API.
+*/
+type LoopbackOptions struct {
+    Addr    string
+    Path    string
+    Timeout time.Duration
+    Open    func(authURL string) error
+    ApiUrl  string
+}
+
+/*
+This function is meant for command line and desktop applications. It starts a temporary listener on localhost,
+waits for ML to redirect the user there with the code, and exchanges the code for the tokens.
+base_site is the auth URL of the site, for instance MLA.
+*/
+func NewLoopbackClient(clientId int64, secret string, base_site string, options LoopbackOptions) (*Client, error) {
+
+    apiUrl := API_URL
+    if options.ApiUrl != "" {
+        apiUrl = options.ApiUrl
+    }
+
+    return newLoopbackClient(clientId, secret, base_site, options, apiUrl)
+}
+
+func newLoopbackClient(clientId int64, secret string, base_site string, options LoopbackOptions, apiUrl string) (*Client, error) {
+
+    if options.Addr == "" {
+        options.Addr = DEFAULT_LOOPBACK_ADDR
+    }
+
+    if options.Path == "" {
+        options.Path = DEFAULT_LOOPBACK_PATH
+    }
+
+    if options.Timeout == 0 {
+        options.Timeout = DEFAULT_LOOPBACK_TIMEOUT
+    }
+
+    listener, err := net.Listen("tcp", options.Addr)
+
+    if err != nil {
+        log.Printf("Error while listening on %s: %s\n", options.Addr, err)
+        return nil, err
+    }
+
+    state, err := newState()
+
+    if err != nil {
+        listener.Close()
+        return nil, err
+    }
+
+    redirectUrl := loopbackRedirectUrl(options.Addr, listener.Addr().String(), options.Path)
+    callback := &loopbackCallback{path: options.Path, state: state, result: make(chan loopbackResult, 1)}
+
+    server := &http.Server{Handler: callback}
+    go server.Serve(listener)
+    defer server.Close()
+
+    authURL := newAuthorizationURL(base_site + "/authorization")
+    authURL.addResponseType("code")
+    authURL.addClientId(clientId)
+    authURL.addRedirectUri(redirectUrl)
+    authURL.addState(state)
+
+    if options.Open != nil {
+        if err := options.Open(authURL.string()); err != nil {
+            log.Printf("Error while opening %s: %s\n", authURL.string(), err)
+        }
+    } else {
+        log.Printf("Visit %s to authorize the application\n", authURL.string())
+    }
+
+    var result loopbackResult
+
+    select {
+    case result = <-callback.result:
+    case <-time.After(options.Timeout):
+        return nil, errors.New("Timeout while waiting for the authorization code.")
+    }
+
+    if result.err != nil {
+        return nil, result.err
+    }
+
+    client := &Client{Id:clientId, Code:result.code, Secret:secret, RedirectUrl:redirectUrl, ApiUrl:apiUrl}
+
+    auth, err := client.authorize()
+
+    if err != nil {
+        return nil, err
+    }
+
+    client.Auth = *auth
+
+    return client, nil
+}
+
+type loopbackResult struct {
+    code string
+    err  error
+}
+
+//Handles the redirect sent by ML. Only the first valid call is taken into account.
+type loopbackCallback struct {
+    path   string
+    state  string
+    result chan loopbackResult
+}
+
+func (c *loopbackCallback) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+    if r.URL.Path != c.path {
+        http.NotFound(w, r)
+        return
+    }
+
+    query := r.URL.Query()
+
+    if query.Get("state") != c.state {
+        http.Error(w, "The state does not match.", http.StatusBadRequest)
+        return
+    }
+
+    var result loopbackResult
+
+    if reason := query.Get("error"); reason != "" {
+        result.err = fmt.Errorf("The authorization was denied: %s %s", reason, query.Get("error_description"))
+        fmt.Fprintln(w, "The application was not authorized. You may close this window.")
+    } else if code := query.Get("code"); code != "" {
+        result.code = code
+        fmt.Fprintln(w, "The application was authorized. You may close this window.")
+    } else {
+        http.Error(w, "The code is missing.", http.StatusBadRequest)
+        return
+    }
+
+    select {
+    case c.result <- result:
+    default:
+    }
+}
+
+/*
+The host is kept as it was given (localhost is not the same as 127.0.0.1 for ML), while the port
+is taken from the listener since it may have been chosen by the system.
+*/
+func loopbackRedirectUrl(addr string, listening string, path string) string {
+
+    host, _, _ := net.SplitHostPort(addr)
+    _, port, _ := net.SplitHostPort(listening)
+
+    if host == "" {
+        host = "127.0.0.1"
+    }
+
+    return "http://" + net.JoinHostPort(host, port) + path
+}
+
+func newState() (string, error) {
+
+    random := make([]byte, 16)
+
+    if _, err := rand.Read(random); err != nil {
+        return "", err
+    }
+
+    return hex.EncodeToString(random), nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "net/http/httptest"
+    "net/url"
+    "time"
+)
+
+func newTestOAuthServer() *httptest.Server {
+    return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path != "/oauth/token" || r.URL.Query().Get("code") != "valid code" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        w.Write([]byte("{\"access_token\":\"valid token\",\"expires_in\":10800,\"refresh_token\":\"valid refresh token\",\"user_id\":123456}"))
+    }))
+}
+
+//Simulates the browser being redirected by ML to the loopback listener.
+func redirectBrowser(code string, state func(string) string) func(string) error {
+    return func(authURL string) error {
+        parsed, _ := url.Parse(authURL)
+        query := parsed.Query()
+        go http.Get(query.Get("redirect_uri") + "?code=" + url.QueryEscape(code) + "&state=" + url.QueryEscape(state(query.Get("state"))))
+        return nil
+    }
+}
+
+func Test_loopback_client_exchanges_the_code_received_on_localhost(t *testing.T) {
+
+    server := newTestOAuthServer()
+    defer server.Close()
+
+    sameState := func(state string) string { return state }
+    client, err := newLoopbackClient(CLIENT_ID, CLIENT_SECRET, MLA, LoopbackOptions{Open:redirectBrowser("valid code", sameState)}, server.URL)
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    if client.Auth.AccessToken != "valid token" || client.Auth.UserId != 123456 {
+        log.Printf("unexpected authorization %v\n", client.Auth)
+        t.FailNow()
+    }
+}
+
+func Test_loopback_client_ignores_redirects_with_a_different_state(t *testing.T) {
+
+    server := newTestOAuthServer()
+    defer server.Close()
+
+    otherState := func(state string) string { return "forged" }
+    options := LoopbackOptions{Open:redirectBrowser("valid code", otherState), Timeout:200 * time.Millisecond}
+
+    _, err := newLoopbackClient(CLIENT_ID, CLIENT_SECRET, MLA, options, server.URL)
+
+    if err == nil {
+        log.Printf("a redirect with a forged state should not be accepted\n")
+        t.FailNow()
+    }
+}
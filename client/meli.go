@@ -34,7 +34,6 @@ import (
     "strconv"
     "bytes"
     "net/http"
-    "fmt"
     "io"
     "encoding/json"
     "io/ioutil"
@@ -84,6 +83,7 @@ type Client struct {
     Code        string
     RedirectUrl string
     Auth        Authorization
+    HttpClient  *http.Client
 }
 const (
     API_URL = "https://api.mercadolibre.com"
@@ -121,6 +121,17 @@ func NewClient(id int64, code string, secret string, redirectUrl string) (*Clien
     return client, nil
 }
 
+/*
+This client is built from an Authorization obtained before (for instance, one that was stored).
+No call is done to the API. The token will be refreshed when it expires.
+*/
+func NewClientWithAuthorization(id int64, secret string, redirectUrl string, auth Authorization) (*Client, error) {
+
+    client := &Client{Id:id, Secret:secret, RedirectUrl:redirectUrl, ApiUrl:API_URL, Auth:auth}
+
+    return client, nil
+}
+
 /*
 This client may be used to access public API which does not need authorization
 */
@@ -169,7 +180,7 @@ func (client *Client) authorize() (*Authorization, error) {
     authURL.addCode(client.Code)
     authURL.addRedirectUri(client.RedirectUrl)
 
-    resp, err := http.Post(authURL.string(), "application/json", *(new(io.Reader)))
+    resp, err := client.httpClient().Post(authURL.string(), "application/json", *(new(io.Reader)))
 
     if err != nil {
         log.Printf("Error when posting: %s", err)
@@ -196,94 +207,42 @@ func (client *Client) authorize() (*Authorization, error) {
 
 //HTTP Methods
 func (client *Client) Get(resourcePath string) (*http.Response, error) {
-
-    apiUrl, err := client.getAuthorizedURL(resourcePath)
-
-    if err != nil {
-        log.Printf("Error while refreshing token")
-        return nil, err
-    }
-
-    resp, err := http.Get(apiUrl.string())
-
-    if err != nil {
-        fmt.Printf("Error while calling url: %s \n Error: %s", apiUrl.string(), err)
-        return nil, err
-    }
-
-    return resp, err
+    return client.NewRequest(http.MethodGet, resourcePath).Do()
 }
 
 func (client *Client) Post(resourcePath string, body string) (*http.Response, error){
-
-    apiUrl, err := client.getAuthorizedURL(resourcePath)
-
-    if err != nil {
-        log.Printf("Error while refreshing token")
-        return nil, err
-    }
-
-    resp, err := http.Post(apiUrl.string(), "application/json", bytes.NewReader([]byte(body)))
-
-    if err != nil {
-        fmt.Printf("Error while calling url: %s \n Error: %s", apiUrl.string(), err)
-        return nil, err
-    }
-
-    return resp, nil
+    return client.NewRequest(http.MethodPost, resourcePath).Body(strings.NewReader(body)).Do()
 }
 
 func (client *Client) Put(resourcePath string, body *string) (*http.Response, error){
+    return client.NewRequest(http.MethodPut, resourcePath).Body(jsonBody(body)).Do()
+}
 
-    apiUrl, err := client.getAuthorizedURL(resourcePath)
-
-    if err != nil {
-        log.Printf("Error while refreshing token")
-        return nil, err
-    }
-
-    req, err := http.NewRequest(http.MethodPut, apiUrl.string(), strings.NewReader(*body))
-
-    if err != nil {
-        log.Printf("Error when creating PUT request %d.", err)
-        return nil, err
-    }
-
-    req.Header.Add("Content-Type", "application/json")
-    resp, err := http.DefaultClient.Do(req)
-
-    if err != nil {
-        fmt.Printf("Error while calling url: %s\n Error: %s", apiUrl.string(), err)
-        return nil, err
-    }
-
-    return resp, nil
+func (client *Client) Patch(resourcePath string, body *string) (*http.Response, error){
+    return client.NewRequest(http.MethodPatch, resourcePath).Body(jsonBody(body)).Do()
 }
 
 func (client *Client) Delete(resourcePath string ) (*http.Response, error) {
+    return client.NewRequest(http.MethodDelete, resourcePath).Do()
+}
 
-    apiUrl, err := client.getAuthorizedURL(resourcePath)
-
-    if err != nil {
-        log.Printf("Error while refreshing token")
-        return nil, err
-    }
-
-    req, err := http.NewRequest(http.MethodDelete, apiUrl.string(), nil)
+func (client *Client) Head(resourcePath string) (*http.Response, error) {
+    return client.NewRequest(http.MethodHead, resourcePath).Do()
+}
 
-    if err != nil {
-        log.Printf("Error when creating Delete request %d.", err)
-        return nil, err
-    }
+func (client *Client) Options(resourcePath string) (*http.Response, error) {
+    return client.NewRequest(http.MethodOptions, resourcePath).Do()
+}
 
-    resp, err := http.DefaultClient.Do(req)
+/*
+This method refreshes the token right away, no matter whether it has expired or not.
+*/
+func (client *Client) RefreshToken() error {
 
-    if err != nil {
-        fmt.Printf("Error while calling url: %s \n Error: %s", apiUrl.string(), err)
-        return nil, err
-    }
+    authMutex.Lock()
+    defer authMutex.Unlock()
 
-    return resp, nil
+    return refreshTok(client)
 }
 
 //This method has side effects. Alters the token that is within the client.
@@ -295,7 +254,7 @@ func hookRefreshToken(client *Client) error {
     authorizationURL.addClientSecret(client.Secret)
     authorizationURL.addRefreshToken(client.Auth.RefreshToken)
 
-    resp, err := http.Post(authorizationURL.string(), "application/json", *(new(io.Reader)))
+    resp, err := client.httpClient().Post(authorizationURL.string(), "application/json", *(new(io.Reader)))
 
     if err != nil {
         log.Printf("Error while refreshing token: %s\n", err.Error())
@@ -319,43 +278,14 @@ func hookRefreshToken(client *Client) error {
     log.Printf("auth received at: %d expires in:%d\n", client.Auth.ReceivedAt, client.Auth.ExpiresIn)
     return nil
 }
-/*
-This method returns the URL + Token to be used by each HTTP request.
-If Token needs to be refreshed, then this method will send a POST to ML API to refresh it.
- */
-func (client *Client) getAuthorizedURL(resourcePath string) (*AuthorizationURL, error){
-
-    finalUrl := newAuthorizationURL(client.ApiUrl + resourcePath)
-    var err error
-
-    if client.Auth != ANONYMOUS {
-
-        authMutex.Lock()
-
-       if client.Auth.isExpired() {
-            log.Printf("token has expired....refreshing...\n")
-            err := refreshTok(client)
-
-            if err != nil {
-                log.Printf("Error while refreshing token %s\n", err.Error())
-                return nil, err
-            }
-       }
-
-        authMutex.Unlock()
-        finalUrl.addAccessToken(client.Auth.AccessToken)
-    }
-
-    return finalUrl, err
-}
-
 type Authorization struct {
     AccessToken  string  `json:"access_token"`
     TokenType    string  `json:"token_type"`
     ExpiresIn    int16   `json:"expires_in"`
-    ReceivedAt   int64
+    ReceivedAt   int64   `json:"received_at"`
     RefreshToken string  `json:"refresh_token"`
     Scope        string  `json:"scope"`
+    UserId       int64   `json:"user_id"`
 }
 
 func (auth Authorization) isExpired() bool {
@@ -399,6 +329,10 @@ func (u *AuthorizationURL) addResponseType(value string) {
     u.Add("response_type=" + url.QueryEscape(value))
 }
 
+func (u *AuthorizationURL) addState(value string) {
+    u.Add("state=" + url.QueryEscape(value))
+}
+
 func (u *AuthorizationURL) addAccessToken(t string){
     u.Add("access_token=" + url.QueryEscape(t))
 }
@@ -409,9 +343,11 @@ func (u *AuthorizationURL) string() string {
 
 func (u *AuthorizationURL) Add(value string) {
 
-    if !strings.Contains(u.url.String(), "?"){
+    current := u.url.String()
+
+    if !strings.Contains(current, "?"){
         u.url.WriteString("?" + value)
-    } else if strings.LastIndex("&",u.url.String()) >= u.url.Len(){
+    } else if strings.HasSuffix(current, "?") || strings.HasSuffix(current, "&"){
         u.url.WriteString(value)
     } else {
         u.url.WriteString("&" + value)
@@ -102,7 +102,7 @@ func Test_POST_a_new_item_works_properly_when_token_IS_EXPIRED(t *testing.T){
     }
 
     if resp.StatusCode != http.StatusCreated {
-        log.Printf("Error while posting a new item status code: %s\n", resp.StatusCode)
+        log.Printf("Error while posting a new item status code: %d\n", resp.StatusCode)
         t.FailNow()
     }
 }
@@ -120,7 +120,7 @@ func Test_POST_a_new_item_works_properly_when_token_IS_NOT_EXPIRED (t *testing.T
     }
 
     if resp.StatusCode != http.StatusCreated {
-        log.Printf("Error while posting a new item status code: %s\n", resp.StatusCode)
+        log.Printf("Error while posting a new item status code: %d\n", resp.StatusCode)
         t.FailNow()
     }
 }
@@ -138,7 +138,7 @@ func Test_PUT_a_new_item_works_properly_when_token_IS_NOT_EXPIRED (t *testing.T)
     }
 
     if resp.StatusCode != http.StatusOK {
-        log.Printf("Error while putting a new item. Status code: %s\n", resp.StatusCode)
+        log.Printf("Error while putting a new item. Status code: %d\n", resp.StatusCode)
         t.FailNow()
     }
 }
@@ -156,7 +156,7 @@ func Test_PUT_a_new_item_works_properly_when_token_IS_EXPIRED (t *testing.T){
     }
 
     if resp.StatusCode != http.StatusOK {
-        log.Printf("Error while putting a new item. Status code: %s\n", resp.StatusCode)
+        log.Printf("Error while putting a new item. Status code: %d\n", resp.StatusCode)
         t.FailNow()
     }
 }
@@ -173,7 +173,7 @@ func Test_DELETE_an_item_returns_200_when_token_IS_NOT_EXPIRED (t *testing.T){
     }
 
     if resp.StatusCode != http.StatusOK {
-        log.Printf("Error while putting a new item. Status code: %s\n", resp.StatusCode)
+        log.Printf("Error while putting a new item. Status code: %d\n", resp.StatusCode)
         t.FailNow()
     }
 }
@@ -189,7 +189,7 @@ func Test_DELETE_an_item_returns_200_when_token_IS_EXPIRED (t *testing.T){
         t.FailNow()
     }
     if resp.StatusCode != http.StatusOK {
-        log.Printf("Error while putting a new item. Status code: %s\n", resp.StatusCode)
+        log.Printf("Error while putting a new item. Status code: %d\n", resp.StatusCode)
         t.FailNow()
     }
 }
@@ -0,0 +1,295 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+    "net/url"
+    "path/filepath"
+    "regexp"
+    "strconv"
+    "strings"
+    "unicode/utf8"
+)
+
+const (
+    MAX_MESSAGE_LENGTH    = 350
+    MAX_ATTACHMENT_SIZE   = 25 * 1024 * 1024
+    MAX_ATTACHMENTS       = 25
+    POST_SALE_MESSAGE_TAG = "post_sale"
+)
+
+var (
+    //Contact data may not be shared through post-sale messages.
+    emailRegexp = regexp.MustCompile(`[\w.+-]+@[\w-]+\.[\w.-]+`)
+    urlRegexp   = regexp.MustCompile(`(?i)\b(https?://|www\.)\S+`)
+    //Phones are written in groups, such as +54 11 4444-5555 or (011) 4444 5555. Groups joined to letters or other
+    //dashes are left out, since they are dates or tracking numbers.
+    phoneRegexp = regexp.MustCompile(`(?:^|[^\w-])(?:\+\d{1,3}[\s.-]?)?(?:\(\d{2,4}\)[\s.-]?|\d{2,4}[\s.-])?\d{3,4}[\s.-]\d{4}(?:$|[^\w-])`)
+    //Plain digit runs are usually order or shipment ids, so they are phones only with an international prefix
+    //or after words such as tel, llamame or whatsapp.
+    phoneRunRegexp = regexp.MustCompile(`(?i)(?:(?:^|[^\w+])\+\d{10,13}|\b(?:tel[eé]fono|tel|celular|cel|ll[aá]mame|whatsapp|wsp|wpp)\b[^\d\n]{0,20}\+?\d{8,13})(?:$|\D)`)
+    tagRegexp      = regexp.MustCompile(`<[a-zA-Z/][^>]*>`)
+
+    allowedAttachmentExtensions = map[string]bool{".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".pdf": true, ".txt": true}
+)
+
+var ErrAttachmentTooLarge = fmt.Errorf("The attachment is bigger than %d bytes.", MAX_ATTACHMENT_SIZE)
+
+type Message struct {
+    Id                string              `json:"id"`
+    SiteId            string              `json:"site_id"`
+    ClientId          int64               `json:"client_id"`
+    From              MessageUser         `json:"from"`
+    To                MessageUser         `json:"to"`
+    Status            string              `json:"status"`
+    Text              string              `json:"text"`
+    MessageDate       MessageDate         `json:"message_date"`
+    MessageModeration MessageModeration   `json:"message_moderation"`
+    Attachments       []MessageAttachment `json:"message_attachments"`
+}
+
+type MessageUser struct {
+    UserId int64 `json:"user_id"`
+}
+
+type MessageDate struct {
+    Received  string `json:"received"`
+    Available string `json:"available"`
+    Notified  string `json:"notified"`
+    Created   string `json:"created"`
+    Read      string `json:"read"`
+}
+
+type MessageModeration struct {
+    Status string `json:"status"`
+    Reason string `json:"reason"`
+}
+
+type MessageAttachment struct {
+    Filename         string `json:"filename"`
+    OriginalFilename string `json:"original_filename"`
+    Type             string `json:"type"`
+    Size             int64  `json:"size"`
+    CreationDate     string `json:"date_created"`
+}
+
+//Tells whether the message was already read by its receiver.
+func (m *Message) IsRead() bool {
+    return m.MessageDate.Read != ""
+}
+
+/*
+Conversation is a page of the messages of a pack.
+*/
+type Conversation struct {
+    Paging             Paging `json:"paging"`
+    ConversationStatus struct {
+        Status    string `json:"status"`
+        Substatus string `json:"substatus"`
+    } `json:"conversation_status"`
+    Messages []Message `json:"messages"`
+}
+
+/*
+Messages gives typed access to the post-sale messages exchanged with the buyer of a pack.
+*/
+type Messages struct {
+    client *Client
+}
+
+func (client *Client) Messages() *Messages {
+    return &Messages{client: client}
+}
+
+func packMessagesPath(packId int64, sellerId int64) string {
+    return "/messages/packs/" + strconv.FormatInt(packId, 10) + "/sellers/" + strconv.FormatInt(sellerId, 10)
+}
+
+/*
+This method returns a Pager over the conversation of the pack. Each page is decoded into a Conversation.
+Reading the messages through the API does not mark them as read; use MarkRead for that.
+*/
+func (m *Messages) Conversation(packId int64, sellerId int64, limit int) *Pager {
+
+    params := url.Values{}
+    params.Set("tag", POST_SALE_MESSAGE_TAG)
+    params.Set("mark_as_read", "false")
+
+    return m.client.NewPager(packMessagesPath(packId, sellerId), params, limit)
+}
+
+//Reads every page of the conversation.
+func (m *Messages) All(packId int64, sellerId int64) ([]Message, error) {
+
+    pager := m.Conversation(packId, sellerId, 0)
+    var messages []Message
+
+    for pager.HasNext() {
+        var page Conversation
+
+        if err := pager.Next(&page); err != nil {
+            return nil, err
+        }
+
+        if len(page.Messages) == 0 {
+            break
+        }
+        messages = append(messages, page.Messages...)
+    }
+
+    return messages, nil
+}
+
+/*
+This method sends a text message to the buyer. attachments are the ids returned by UploadAttachment.
+The text is checked with CheckMessage before sending it.
+*/
+func (m *Messages) Send(packId int64, sellerId int64, buyerId int64, text string, attachments ...string) (*Message, error) {
+
+    if problems := CheckMessage(text); len(problems) > 0 {
+        return nil, errors.New("The message is not valid: " + strings.Join(problems, " "))
+    }
+
+    if len(attachments) > MAX_ATTACHMENTS {
+        return nil, fmt.Errorf("At most %d attachments may be sent.", MAX_ATTACHMENTS)
+    }
+
+    body := struct {
+        From        MessageUser `json:"from"`
+        To          MessageUser `json:"to"`
+        Text        string      `json:"text"`
+        Attachments []string    `json:"attachments,omitempty"`
+    }{From: MessageUser{UserId: sellerId}, To: MessageUser{UserId: buyerId}, Text: text, Attachments: attachments}
+
+    message := new(Message)
+
+    err := m.client.NewRequest(http.MethodPost, packMessagesPath(packId, sellerId)).
+        Query("tag", POST_SALE_MESSAGE_TAG).
+        JSON(body).
+        Decode(message)
+
+    if err != nil {
+        return nil, err
+    }
+
+    return message, nil
+}
+
+func (m *Messages) MarkRead(messageIds ...string) error {
+
+    if len(messageIds) == 0 {
+        return nil
+    }
+
+    return m.client.NewRequest(http.MethodPut, "/messages/mark_as_read/"+strings.Join(messageIds, ",")).
+        Query("tag", POST_SALE_MESSAGE_TAG).
+        Decode(nil)
+}
+
+/*
+This method uploads a file to be attached to a message and returns its id. size is checked before sending it;
+use -1 when it is unknown, and the limit will be checked while streaming.
+*/
+func (m *Messages) UploadAttachment(siteId string, fileName string, content io.Reader, size int64) (string, error) {
+
+    if !allowedAttachmentExtensions[strings.ToLower(filepath.Ext(fileName))] {
+        return "", fmt.Errorf("The attachment %s has a format which is not allowed.", fileName)
+    }
+
+    if size > MAX_ATTACHMENT_SIZE {
+        return "", ErrAttachmentTooLarge
+    }
+
+    var attachment struct {
+        Id string `json:"id"`
+    }
+
+    err := m.client.NewRequest(http.MethodPost, "/messages/attachments").
+        Query("tag", POST_SALE_MESSAGE_TAG).
+        Query("site_id", siteId).
+        Multipart("file", filepath.Base(fileName), &uploadReader{reader: content, total: size, max: MAX_ATTACHMENT_SIZE, tooLarge: ErrAttachmentTooLarge}).
+        Decode(&attachment)
+
+    if err != nil {
+        return "", err
+    }
+
+    return attachment.Id, nil
+}
+
+//Writes the content of the attachment to w.
+func (m *Messages) DownloadAttachment(siteId string, attachmentId string, w io.Writer) error {
+
+    resp, err := m.client.NewRequest(http.MethodGet, "/messages/attachments/"+attachmentId).
+        Query("tag", POST_SALE_MESSAGE_TAG).
+        Query("site_id", siteId).
+        Do()
+
+    if err != nil {
+        return err
+    }
+
+    if resp.StatusCode >= http.StatusBadRequest {
+        return decodeResponse(resp, nil)
+    }
+
+    defer resp.Body.Close()
+
+    _, err = io.Copy(w, resp.Body)
+    return err
+}
+
+/*
+This function checks the rules of the post-sale messages: the text may not be empty nor longer than
+MAX_MESSAGE_LENGTH, and it may not have HTML nor contact data (emails, links or phone numbers).
+It returns the problems found; an empty list means the text may be sent.
+*/
+func CheckMessage(text string) []string {
+
+    var problems []string
+    trimmed := strings.TrimSpace(text)
+
+    if trimmed == "" {
+        problems = append(problems, "The text is empty.")
+    }
+
+    if length := utf8.RuneCountInString(text); length > MAX_MESSAGE_LENGTH {
+        problems = append(problems, fmt.Sprintf("The text has %d characters but the maximum is %d.", length, MAX_MESSAGE_LENGTH))
+    }
+
+    if tagRegexp.MatchString(text) {
+        problems = append(problems, "HTML is not allowed.")
+    }
+
+    if emailRegexp.MatchString(text) {
+        problems = append(problems, "Emails are not allowed.")
+    }
+
+    if urlRegexp.MatchString(text) {
+        problems = append(problems, "Links are not allowed.")
+    }
+
+    if phoneRegexp.MatchString(text) || phoneRunRegexp.MatchString(text) {
+        problems = append(problems, "Phone numbers are not allowed.")
+    }
+
+    return problems
+}
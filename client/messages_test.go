@@ -0,0 +1,156 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "bytes"
+    "strings"
+    "io/ioutil"
+    "fmt"
+)
+
+func Test_conversation_is_read_page_by_page_without_marking_it_as_read(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        query := r.URL.Query()
+        if r.URL.Path != "/messages/packs/10/sellers/20" || query.Get("mark_as_read") != "false" || query.Get("limit") != "50" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        fmt.Fprintf(w, "{\"paging\":{\"total\":3,\"offset\":%s,\"limit\":50},\"messages\":[{\"id\":\"m%s\",\"text\":\"hi\"}]}", query.Get("offset"), query.Get("offset"))
+    })
+    defer server.Close()
+
+    pager := client.Messages().Conversation(10, 20, 0)
+    var page Conversation
+
+    if err := pager.Next(&page); err != nil || len(page.Messages) != 1 || page.Messages[0].Id != "m0" || pager.Paging().Total != 3 {
+        log.Printf("unexpected page %v %v\n", page, err)
+        t.FailNow()
+    }
+
+    if pager.HasNext() {
+        log.Printf("a single page of 50 should hold the whole conversation\n")
+        t.FailNow()
+    }
+
+    if err := pager.Next(&page); err == nil {
+        log.Printf("reading past the last page should fail\n")
+        t.FailNow()
+    }
+}
+
+func Test_messages_with_contact_data_are_not_sent(t *testing.T) {
+
+    calls := 0
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        calls++
+        w.Write([]byte("{\"id\":\"m1\",\"text\":\"Your order is on its way\"}"))
+    })
+    defer server.Close()
+
+    texts := []string{"", strings.Repeat("a", MAX_MESSAGE_LENGTH+1), "write me at buyer@mail.com", "call 11 4444-5555", "see www.shop.com", "<b>hi</b>"}
+
+    for _, text := range texts {
+        if _, err := client.Messages().Send(10, 20, 30, text); err == nil {
+            log.Printf("the message %q should not be sent\n", text)
+            t.FailNow()
+        }
+    }
+
+    message, err := client.Messages().Send(10, 20, 30, "Your order is on its way")
+
+    if err != nil || message.Id != "m1" || calls != 1 {
+        log.Printf("unexpected message %v %v %d\n", message, err, calls)
+        t.FailNow()
+    }
+}
+
+func Test_attachments_are_uploaded_and_downloaded(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch {
+        case r.Method == http.MethodPost && r.URL.Path == "/messages/attachments":
+            file, header, err := r.FormFile("file")
+            if err != nil || header.Filename != "invoice.pdf" {
+                w.WriteHeader(http.StatusBadRequest)
+                return
+            }
+            content, _ := ioutil.ReadAll(file)
+            if string(content) != "%PDF" {
+                w.WriteHeader(http.StatusBadRequest)
+                return
+            }
+            w.Write([]byte("{\"id\":\"att_1\"}"))
+        case r.Method == http.MethodGet && r.URL.Path == "/messages/attachments/att_1":
+            w.Write([]byte("%PDF"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    id, err := client.Messages().UploadAttachment("MLA", "/tmp/invoice.pdf", strings.NewReader("%PDF"), 4)
+
+    if err != nil || id != "att_1" {
+        log.Printf("unexpected attachment %s %v\n", id, err)
+        t.FailNow()
+    }
+
+    if _, err := client.Messages().UploadAttachment("MLA", "tool.exe", strings.NewReader("MZ"), 2); err == nil {
+        log.Printf("executables should not be uploaded\n")
+        t.FailNow()
+    }
+
+    var out bytes.Buffer
+
+    if err := client.Messages().DownloadAttachment("MLA", "att_1", &out); err != nil || out.String() != "%PDF" {
+        log.Printf("unexpected download %s %v\n", out.String(), err)
+        t.FailNow()
+    }
+}
+
+func Test_phone_check_leaves_out_dates_ids_and_tracking_numbers(t *testing.T) {
+
+    phones := []string{"call me 11 4444-5555", "+54 9 11 4444 5555", "(011) 4444.5555", "4444-5555",
+        "Llamame al 1144445555", "+5491144445555", "Mi whatsapp: 5491144445555", "cel 1144445555", "Tel.+541144445555"}
+
+    for _, text := range phones {
+        if len(CheckMessage(text)) == 0 {
+            log.Printf("the phone in %q should be detected\n", text)
+            t.FailNow()
+        }
+    }
+
+    texts := []string{
+        "Your order 2000001234 shipped on 2016-06-20, tracking 3A-1234-5678",
+        "It will arrive on 20/06/2016 at 10:30",
+        "Invoice 0001-00001234 for $ 1.234,50 is attached",
+        "Shipment 27512345678 is ready",
+        "Hotel 12345678 confirmed the delivery of order 2000001234",
+    }
+
+    for _, text := range texts {
+        if problems := CheckMessage(text); len(problems) > 0 {
+            log.Printf("%q should be allowed but %v\n", text, problems)
+            t.FailNow()
+        }
+    }
+}
@@ -0,0 +1,287 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "math"
+    "math/big"
+    "strconv"
+    "strings"
+)
+
+const (
+    //Money keeps MONEY_DECIMALS decimal places; MONEY_SCALE is the amount of units of a Money in 1.
+    MONEY_DECIMALS = 6
+    MONEY_SCALE    = 1000000
+
+    //Larger amounts do not fit in a Money.
+    MAX_MONEY_DIGITS = 12
+    MAX_MONEY        = Money(999999999999999999)
+)
+
+/*
+Money is a decimal amount with MONEY_DECIMALS fixed decimal places, so prices like 0.1 are kept exactly and
+amounts can be added and compared with the usual operators. It is encoded in JSON as a number.
+The currency is not part of it; ML always sends it apart, as currency_id.
+
+Amounts have to be created with ParseMoney, MoneyFromInt or MoneyFromFloat, since Money(10) is 0.00001.
+A Money holds up to MAX_MONEY_DIGITS integer digits. The usual operators do not check that limit and wrap around
+silently past it, so Add, MulInt and Mul should be used when the amounts may be that large; they return
+ErrMoneyOverflow instead.
+*/
+type Money int64
+
+var ErrInvalidMoney = errors.New("The amount is not a valid decimal number.")
+
+var ErrMoneyOverflow = fmt.Errorf("The amount has more than %d integer digits.", MAX_MONEY_DIGITS)
+
+//Returns the Money for a whole amount. Amounts with more than MAX_MONEY_DIGITS digits wrap around silently.
+func MoneyFromInt(units int64) Money {
+    return Money(units * MONEY_SCALE)
+}
+
+//Returns the Money nearest to f. Amounts with more than MAX_MONEY_DIGITS integer digits are not checked either.
+func MoneyFromFloat(f float64) Money {
+    return Money(math.Round(f * MONEY_SCALE))
+}
+
+/*
+This function parses a decimal number such as 1234.5 or -0.01. Digits beyond MONEY_DECIMALS are rounded half away from zero.
+*/
+func ParseMoney(s string) (Money, error) {
+
+    s = strings.TrimSpace(s)
+
+    if strings.ContainsAny(s, "eE") {
+        f, err := strconv.ParseFloat(s, 64)
+        if err != nil || math.Abs(f) >= math.Pow10(MAX_MONEY_DIGITS) {
+            return 0, ErrInvalidMoney
+        }
+        return MoneyFromFloat(f), nil
+    }
+
+    negative := strings.HasPrefix(s, "-")
+    s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+    parts := strings.SplitN(s, ".", 2)
+    integer, fraction := parts[0], ""
+    if len(parts) == 2 {
+        fraction = parts[1]
+    }
+
+    if integer == "" && fraction == "" || len(strings.TrimLeft(integer, "0")) > MAX_MONEY_DIGITS || !isDigits(integer) || !isDigits(fraction) {
+        return 0, ErrInvalidMoney
+    }
+
+    roundUp := len(fraction) > MONEY_DECIMALS && fraction[MONEY_DECIMALS] >= '5'
+    if len(fraction) > MONEY_DECIMALS {
+        fraction = fraction[:MONEY_DECIMALS]
+    }
+    fraction += strings.Repeat("0", MONEY_DECIMALS-len(fraction))
+
+    units, err := strconv.ParseInt("0"+integer+fraction, 10, 64)
+
+    if err != nil {
+        return 0, ErrInvalidMoney
+    }
+
+    if roundUp {
+        units++
+    }
+
+    if Money(units) > MAX_MONEY {
+        return 0, ErrMoneyOverflow
+    }
+
+    if negative {
+        units = -units
+    }
+
+    return Money(units), nil
+}
+
+func isDigits(s string) bool {
+
+    for _, r := range s {
+        if r < '0' || r > '9' {
+            return false
+        }
+    }
+
+    return true
+}
+
+func (m Money) Float64() float64 {
+    return float64(m) / MONEY_SCALE
+}
+
+func (m Money) Abs() Money {
+
+    if m < 0 {
+        return -m
+    }
+
+    return m
+}
+
+func checkedMoney(n *big.Int) (Money, error) {
+
+    if n.CmpAbs(big.NewInt(int64(MAX_MONEY))) > 0 {
+        return 0, ErrMoneyOverflow
+    }
+
+    return Money(n.Int64()), nil
+}
+
+func (m Money) Add(other Money) (Money, error) {
+    return checkedMoney(new(big.Int).Add(big.NewInt(int64(m)), big.NewInt(int64(other))))
+}
+
+//Multiplies by n units, for instance the quantity of an order item.
+func (m Money) MulInt(n int) (Money, error) {
+    return checkedMoney(new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(int64(n))))
+}
+
+//Multiplies by a decimal factor, rounding half away from zero.
+func (m Money) Mul(factor Money) (Money, error) {
+
+    product := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(int64(factor)))
+    return checkedMoney(divRound(product, MONEY_SCALE))
+}
+
+//Rounds to the given decimal places, half away from zero.
+func (m Money) Round(places int) Money {
+
+    if places >= MONEY_DECIMALS {
+        return m
+    }
+
+    if places < 0 {
+        places = 0
+    }
+
+    unit := int64(math.Pow10(MONEY_DECIMALS - places))
+
+    return Money(divRound(big.NewInt(int64(m)), unit).Int64() * unit)
+}
+
+/*
+Multiplies by a factor with more decimals than a Money may keep, such as an exchange rate, and rounds the product
+once to the given decimal places, half away from zero.
+*/
+func (m Money) mulRat(factor *big.Rat, places int) (Money, error) {
+
+    if places > MONEY_DECIMALS {
+        places = MONEY_DECIMALS
+    }
+
+    if places < 0 {
+        places = 0
+    }
+
+    unit := int64(math.Pow10(MONEY_DECIMALS - places))
+
+    product := new(big.Rat).Mul(new(big.Rat).SetInt64(int64(m)), factor)
+    product.Quo(product, new(big.Rat).SetInt64(unit))
+
+    quotient, remainder := new(big.Int).QuoRem(product.Num(), product.Denom(), new(big.Int))
+
+    if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(product.Denom()) >= 0 {
+        if product.Sign() < 0 {
+            quotient.Sub(quotient, big.NewInt(1))
+        } else {
+            quotient.Add(quotient, big.NewInt(1))
+        }
+    }
+
+    return checkedMoney(quotient.Mul(quotient, big.NewInt(unit)))
+}
+
+func divRound(n *big.Int, d int64) *big.Int {
+
+    quotient, remainder := new(big.Int).QuoRem(n, big.NewInt(d), new(big.Int))
+
+    if new(big.Int).Abs(remainder).Int64()*2 >= d {
+        if n.Sign() < 0 {
+            quotient.Sub(quotient, big.NewInt(1))
+        } else {
+            quotient.Add(quotient, big.NewInt(1))
+        }
+    }
+
+    return quotient
+}
+
+//Returns the amount with exactly the given decimal places, rounding it when needed.
+func (m Money) StringFixed(places int) string {
+
+    if places > MONEY_DECIMALS {
+        places = MONEY_DECIMALS
+    }
+
+    if places < 0 {
+        places = 0
+    }
+
+    rounded := m.Round(places)
+    sign := ""
+
+    if rounded < 0 {
+        sign = "-"
+        rounded = -rounded
+    }
+
+    integer := strconv.FormatInt(int64(rounded)/MONEY_SCALE, 10)
+
+    if places == 0 {
+        return sign + integer
+    }
+
+    fraction := fmt.Sprintf("%06d", int64(rounded)%MONEY_SCALE)[:places]
+
+    return sign + integer + "." + fraction
+}
+
+//Returns the amount without trailing zeros, for instance 10 or 10.5.
+func (m Money) String() string {
+    return strings.TrimSuffix(strings.TrimRight(m.StringFixed(MONEY_DECIMALS), "0"), ".")
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+    return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+
+    s := strings.Trim(string(data), "\"")
+
+    if s == "null" || s == "" {
+        *m = 0
+        return nil
+    }
+
+    money, err := ParseMoney(s)
+
+    if err != nil {
+        return err
+    }
+
+    *m = money
+    return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "encoding/json"
+)
+
+func Test_money_is_parsed_and_printed_without_losing_decimals(t *testing.T) {
+
+    cases := map[string]string{"0.1": "0.1", "10": "10", "-3.50": "-3.5", "1234.5678905": "1234.567891", "0.0000004": "0", "1e3": "1000"}
+
+    for input, expected := range cases {
+        money, err := ParseMoney(input)
+        if err != nil || money.String() != expected {
+            log.Printf("%s should be %s but is %s %v\n", input, expected, money, err)
+            t.FailNow()
+        }
+    }
+
+    for _, input := range []string{"", "abc", "1.2.3", "12a", "9999999999999"} {
+        if _, err := ParseMoney(input); err == nil {
+            log.Printf("%s should not be parsed\n", input)
+            t.FailNow()
+        }
+    }
+
+    a, _ := ParseMoney("0.1")
+    b, _ := ParseMoney("0.2")
+
+    if a+b != MoneyFromFloat(0.3) || (a + b).String() != "0.3" {
+        log.Printf("0.1 + 0.2 should be exactly 0.3 but is %s\n", a+b)
+        t.FailNow()
+    }
+}
+
+func Test_money_is_rounded_half_away_from_zero(t *testing.T) {
+
+    cases := []struct {
+        amount   string
+        places   int
+        expected string
+    }{
+        {"2.345", 2, "2.35"},
+        {"-2.345", 2, "-2.35"},
+        {"2.344", 2, "2.34"},
+        {"1499.5", 0, "1500"},
+        {"10", 2, "10.00"},
+    }
+
+    for _, c := range cases {
+        money, _ := ParseMoney(c.amount)
+        if rounded := money.StringFixed(c.places); rounded != c.expected {
+            log.Printf("%s rounded to %d places should be %s but is %s\n", c.amount, c.places, c.expected, rounded)
+            t.FailNow()
+        }
+    }
+
+    price, _ := ParseMoney("19.99")
+    rate, _ := ParseMoney("15.5")
+
+    product, _ := price.Mul(rate)
+    total, _ := price.MulInt(3)
+
+    if product.String() != "309.845" || total.String() != "59.97" {
+        log.Printf("unexpected products %s %s\n", product, total)
+        t.FailNow()
+    }
+}
+
+func Test_money_arithmetic_reports_overflows(t *testing.T) {
+
+    if _, err := ParseMoney("999999999999.9999995"); err != ErrMoneyOverflow {
+        log.Printf("rounding up past the limit should overflow %v\n", err)
+        t.FailNow()
+    }
+
+    large, _ := ParseMoney("999999999999")
+
+    if _, err := large.MulInt(10); err != ErrMoneyOverflow {
+        log.Printf("MulInt should overflow %v\n", err)
+        t.FailNow()
+    }
+
+    if _, err := large.Mul(MoneyFromInt(2)); err != ErrMoneyOverflow {
+        log.Printf("Mul should overflow %v\n", err)
+        t.FailNow()
+    }
+
+    if _, err := large.Add(MoneyFromInt(1)); err != ErrMoneyOverflow {
+        log.Printf("Add should overflow %v\n", err)
+        t.FailNow()
+    }
+
+    if sum, err := large.Add(MoneyFromInt(-1)); err != nil || sum.String() != "999999999998" {
+        log.Printf("unexpected sum %s %v\n", sum, err)
+        t.FailNow()
+    }
+}
+
+func Test_money_is_encoded_as_a_json_number(t *testing.T) {
+
+    var item Item
+
+    if err := json.Unmarshal([]byte("{\"price\":1500.10,\"currency_id\":\"ARS\"}"), &item); err != nil || item.Price.String() != "1500.1" {
+        log.Printf("unexpected price %s %v\n", item.Price, err)
+        t.FailNow()
+    }
+
+    body, _ := json.Marshal(Item{Title: "Ray Ban", Price: MoneyFromFloat(10.25)})
+
+    if string(body) != "{\"title\":\"Ray Ban\",\"price\":10.25}" {
+        log.Printf("unexpected json %s\n", body)
+        t.FailNow()
+    }
+
+    body, _ = json.Marshal(Item{Title: "Ray Ban"})
+
+    if string(body) != "{\"title\":\"Ray Ban\"}" {
+        log.Printf("a zero price should be omitted %s\n", body)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,110 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "encoding/json"
+    "log"
+    "net/http"
+    "sync"
+)
+
+const (
+    TOPIC_ITEMS     = "items"
+    TOPIC_ORDERS    = "orders_v2"
+    TOPIC_QUESTIONS = "questions"
+    TOPIC_MESSAGES  = "messages"
+    TOPIC_SHIPMENTS = "shipments"
+    TOPIC_CLAIMS    = "claims"
+    TOPIC_PAYMENTS  = "payments"
+)
+
+/*
+Notification is what ML posts to the callback URL of the application when a resource changes.
+Resource is the path of the resource, for instance /items/MLA123, which has to be fetched for getting its new state.
+*/
+type Notification struct {
+    Id            string `json:"_id"`
+    Resource      string `json:"resource"`
+    UserId        int64  `json:"user_id"`
+    Topic         string `json:"topic"`
+    ApplicationId int64  `json:"application_id"`
+    Attempts      int    `json:"attempts"`
+    Sent          string `json:"sent"`
+    Received      string `json:"received"`
+}
+
+type NotificationFunc func(notification Notification)
+
+/*
+Notifications is an http.Handler for the callback URL of the application. It dispatches each notification
+to the function registered for its topic:
+
+    notifications := client.Notifications()
+    notifications.Handle(sdk.TOPIC_ITEMS, func(n sdk.Notification) { ... })
+    http.Handle("/notifications", notifications)
+
+ML expects an answer within a few hundred milliseconds, so the notification is acknowledged before
+calling the functions, which run on their own goroutine.
+*/
+type Notifications struct {
+    client   *Client
+    mutex    sync.RWMutex
+    handlers map[string]NotificationFunc
+}
+
+func (client *Client) Notifications() *Notifications {
+    return &Notifications{client: client, handlers: map[string]NotificationFunc{}}
+}
+
+//Registers the function to call for the notifications of the topic. It replaces any previous one.
+func (n *Notifications) Handle(topic string, handler NotificationFunc) {
+
+    n.mutex.Lock()
+    defer n.mutex.Unlock()
+
+    n.handlers[topic] = handler
+}
+
+func (n *Notifications) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+    if r.Method != http.MethodPost {
+        w.WriteHeader(http.StatusMethodNotAllowed)
+        return
+    }
+
+    var notification Notification
+
+    if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+        log.Printf("Error while decoding the notification %s\n", err.Error())
+        w.WriteHeader(http.StatusBadRequest)
+        return
+    }
+
+    w.WriteHeader(http.StatusOK)
+
+    n.mutex.RLock()
+    handler := n.handlers[notification.Topic]
+    n.mutex.RUnlock()
+
+    if handler == nil {
+        log.Printf("No handler for the notification of topic %s\n", notification.Topic)
+        return
+    }
+
+    go handler(notification)
+}
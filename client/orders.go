@@ -0,0 +1,142 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "net/http"
+    "net/url"
+    "strconv"
+    "time"
+)
+
+const (
+    ORDER_CONFIRMED        = "confirmed"
+    ORDER_PAYMENT_REQUIRED = "payment_required"
+    ORDER_PAID             = "paid"
+    ORDER_CANCELLED        = "cancelled"
+
+    //Layout of the dates used by the orders API.
+    ORDER_DATE_LAYOUT = "2006-01-02T15:04:05.000-07:00"
+)
+
+type Order struct {
+    Id          int64       `json:"id"`
+    Status      string      `json:"status"`
+    DateCreated string      `json:"date_created"`
+    DateClosed  string      `json:"date_closed"`
+    TotalAmount Money       `json:"total_amount"`
+    PaidAmount  Money       `json:"paid_amount"`
+    CurrencyId  string      `json:"currency_id"`
+    PackId      int64       `json:"pack_id"`
+    Buyer       OrderUser   `json:"buyer"`
+    Seller      OrderUser   `json:"seller"`
+    OrderItems  []OrderItem `json:"order_items"`
+    Payments    []Payment   `json:"payments"`
+    Shipping    struct {
+        Id int64 `json:"id"`
+    } `json:"shipping"`
+    Tags []string `json:"tags"`
+}
+
+type OrderUser struct {
+    Id       int64  `json:"id"`
+    Nickname string `json:"nickname"`
+}
+
+type OrderItem struct {
+    Item struct {
+        Id          string `json:"id"`
+        Title       string `json:"title"`
+        VariationId int64  `json:"variation_id"`
+    } `json:"item"`
+    Quantity   int    `json:"quantity"`
+    UnitPrice  Money  `json:"unit_price"`
+    SaleFee    Money  `json:"sale_fee"`
+    CurrencyId string `json:"currency_id"`
+}
+
+/*
+OrderSearch is a page of the orders found by Search.
+*/
+type OrderSearch struct {
+    Paging  Paging  `json:"paging"`
+    Results []Order `json:"results"`
+}
+
+/*
+Orders gives typed access to the orders API.
+*/
+type Orders struct {
+    client *Client
+}
+
+func (client *Client) Orders() *Orders {
+    return &Orders{client: client}
+}
+
+func (o *Orders) Get(orderId int64) (*Order, error) {
+
+    order := new(Order)
+
+    if err := o.client.NewRequest(http.MethodGet, "/orders/"+strconv.FormatInt(orderId, 10)).Decode(order); err != nil {
+        return nil, err
+    }
+
+    return order, nil
+}
+
+/*
+This method returns a Pager over the orders of the seller. params takes the filters of /orders/search,
+for instance order.status; it may be nil. Each page is decoded into an OrderSearch.
+*/
+func (o *Orders) Search(sellerId int64, params url.Values, limit int) *Pager {
+
+    filters := url.Values{}
+    for key, values := range params {
+        filters[key] = values
+    }
+    filters.Set("seller", strconv.FormatInt(sellerId, 10))
+
+    return o.client.NewPager("/orders/search", filters, limit)
+}
+
+//Returns every order of the seller created between from and to.
+func (o *Orders) Between(sellerId int64, from time.Time, to time.Time) ([]Order, error) {
+
+    params := url.Values{}
+    params.Set("order.date_created.from", from.Format(ORDER_DATE_LAYOUT))
+    params.Set("order.date_created.to", to.Format(ORDER_DATE_LAYOUT))
+    params.Set("sort", "date_asc")
+
+    pager := o.Search(sellerId, params, 0)
+    var orders []Order
+
+    for pager.HasNext() {
+        var page OrderSearch
+
+        if err := pager.Next(&page); err != nil {
+            return nil, err
+        }
+
+        if len(page.Results) == 0 {
+            break
+        }
+        orders = append(orders, page.Results...)
+    }
+
+    return orders, nil
+}
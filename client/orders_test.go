@@ -0,0 +1,54 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "fmt"
+    "time"
+)
+
+func Test_orders_between_two_dates_are_read_from_every_page(t *testing.T) {
+
+    var received []string
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        query := r.URL.Query()
+        if r.URL.Path != "/orders/search" || query.Get("seller") != "2" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        received = append(received, query.Get("order.date_created.from"), query.Get("order.date_created.to"))
+        offset := query.Get("offset")
+        fmt.Fprintf(w, "{\"paging\":{\"total\":60,\"offset\":%s,\"limit\":50},\"results\":[{\"id\":1%s,\"total_amount\":10.5}]}", offset, offset)
+    })
+    defer server.Close()
+
+    from := time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC)
+    orders, err := client.Orders().Between(2, from, from.AddDate(0, 1, 0))
+
+    if err != nil || len(orders) != 2 || orders[1].Id != 150 || orders[0].TotalAmount != MoneyFromFloat(10.5) {
+        log.Printf("unexpected orders %v %v\n", orders, err)
+        t.FailNow()
+    }
+
+    if received[0] != "2016-06-01T00:00:00.000+00:00" || received[1] != "2016-07-01T00:00:00.000+00:00" {
+        log.Printf("unexpected dates %v\n", received)
+        t.FailNow()
+    }
+}
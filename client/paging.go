@@ -0,0 +1,169 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "encoding/json"
+    "errors"
+    "io/ioutil"
+    "log"
+    "net/http"
+    "net/url"
+    "strconv"
+)
+
+const DEFAULT_PAGE_LIMIT = 50
+
+/*
+Paging is returned by every paginated API together with the results.
+*/
+type Paging struct {
+    Total  int `json:"total"`
+    Offset int `json:"offset"`
+    Limit  int `json:"limit"`
+}
+
+/*
+Pager walks through a resource paginated with offset and limit:
+
+    pager := client.NewPager("/users/123/items/search", nil, 50)
+    for pager.HasNext() {
+        var page struct {
+            Results []string `json:"results"`
+        }
+        if err := pager.Next(&page); err != nil {
+            return err
+        }
+    }
+
+Each page is decoded into the value given to Next, so the same Pager works for any paginated API.
+*/
+type Pager struct {
+    client  *Client
+    path    string
+    params  url.Values
+    headers map[string]string
+    limit   int
+    offset  int
+    paging  Paging
+    fetched bool
+}
+
+/*
+This method creates a Pager for the resource. params may be nil. limit <= 0 takes DEFAULT_PAGE_LIMIT.
+*/
+func (client *Client) NewPager(resourcePath string, params url.Values, limit int) *Pager {
+
+    if limit <= 0 {
+        limit = DEFAULT_PAGE_LIMIT
+    }
+
+    if params == nil {
+        params = url.Values{}
+    }
+
+    return &Pager{client: client, path: resourcePath, params: params, headers: map[string]string{}, limit: limit}
+}
+
+//Sets a header sent with every page, for APIs which need one such as a version.
+func (p *Pager) Header(key string, value string) *Pager {
+    p.headers[key] = value
+    return p
+}
+
+//Tells whether there are pages left. It is always true before fetching the first page.
+func (p *Pager) HasNext() bool {
+    return !p.fetched || p.offset < p.paging.Total
+}
+
+//Returns the paging of the last page fetched.
+func (p *Pager) Paging() Paging {
+    return p.paging
+}
+
+/*
+This method fetches the next page and decodes it into v.
+*/
+func (p *Pager) Next(v interface{}) error {
+
+    if !p.HasNext() {
+        return errors.New("There are no more pages.")
+    }
+
+    params := url.Values{}
+    for key, values := range p.params {
+        params[key] = values
+    }
+    params.Set("offset", strconv.Itoa(p.offset))
+    params.Set("limit", strconv.Itoa(p.limit))
+
+    request := p.client.NewRequest(http.MethodGet, p.path).Params(params)
+    for key, value := range p.headers {
+        request.Header(key, value)
+    }
+
+    resp, err := request.Do()
+
+    if err != nil {
+        return err
+    }
+
+    if resp.StatusCode >= http.StatusBadRequest {
+        return decodeResponse(resp, nil)
+    }
+
+    body, err := ioutil.ReadAll(resp.Body)
+    resp.Body.Close()
+
+    if err != nil {
+        return err
+    }
+
+    //Some APIs send the paging at the top level of the page instead of within paging.
+    var page struct {
+        Paging *Paging `json:"paging"`
+        Total  int     `json:"total"`
+        Offset int     `json:"offset"`
+        Limit  int     `json:"limit"`
+    }
+
+    if err := json.Unmarshal(body, &page); err != nil {
+        log.Printf("Error while decoding the paging %s %s", err.Error(), body)
+        return err
+    }
+
+    if page.Paging == nil {
+        page.Paging = &Paging{Total: page.Total, Offset: page.Offset, Limit: page.Limit}
+    }
+
+    if err := json.Unmarshal(body, v); err != nil {
+        log.Printf("Error while decoding the page %s %s", err.Error(), body)
+        return err
+    }
+
+    p.fetched = true
+    p.paging = *page.Paging
+
+    //APIs cap the limit of the pages, so the next page starts after the limit the API applied.
+    if p.paging.Limit > 0 {
+        p.offset += p.paging.Limit
+    } else {
+        p.offset += p.limit
+    }
+
+    return nil
+}
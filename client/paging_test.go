@@ -0,0 +1,62 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "fmt"
+    "strconv"
+    "encoding/json"
+)
+
+func Test_pager_follows_the_limit_applied_by_the_api(t *testing.T) {
+
+    var offsets []string
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+        offsets = append(offsets, r.URL.Query().Get("offset"))
+        //The API caps the pages at 2 results, whatever the limit asked for.
+        results := []string{}
+        for i := offset; i < offset+2 && i < 5; i++ {
+            results = append(results, strconv.Itoa(i))
+        }
+        body, _ := json.Marshal(results)
+        fmt.Fprintf(w, "{\"paging\":{\"total\":5,\"offset\":%d,\"limit\":2},\"results\":%s}", offset, body)
+    })
+    defer server.Close()
+
+    pager := client.NewPager("/users/2/items/search", nil, 5)
+    var all []string
+
+    for pager.HasNext() {
+        var page struct {
+            Results []string `json:"results"`
+        }
+        if err := pager.Next(&page); err != nil {
+            log.Printf("Error: %s\n", err)
+            t.FailNow()
+        }
+        all = append(all, page.Results...)
+    }
+
+    if len(all) != 5 || fmt.Sprint(offsets) != "[0 2 4]" {
+        log.Printf("no result should be skipped %v %v\n", all, offsets)
+        t.FailNow()
+    }
+}
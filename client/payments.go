@@ -0,0 +1,240 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "net/http"
+    "strconv"
+    "time"
+)
+
+const (
+    PAYMENT_APPROVED     = "approved"
+    PAYMENT_PENDING      = "pending"
+    PAYMENT_REJECTED     = "rejected"
+    PAYMENT_REFUNDED     = "refunded"
+    PAYMENT_CANCELLED    = "cancelled"
+    PAYMENT_CHARGED_BACK = "charged_back"
+
+    //Results of the reconciliation of an order
+    RECONCILED                = "reconciled"
+    RECONCILE_MISSING_PAYMENT = "missing_payment"
+    RECONCILE_AMOUNT_MISMATCH = "amount_mismatch"
+    RECONCILE_REFUNDED        = "refunded"
+
+    //Differences below this are considered rounding.
+    RECONCILE_TOLERANCE Money = MONEY_SCALE / 100
+)
+
+type Payment struct {
+    Id                int64  `json:"id"`
+    OrderId           int64  `json:"order_id"`
+    PayerId           int64  `json:"payer_id"`
+    Status            string `json:"status"`
+    StatusDetail      string `json:"status_detail"`
+    TransactionAmount Money  `json:"transaction_amount"`
+    TotalPaidAmount   Money  `json:"total_paid_amount"`
+    ShippingCost      Money  `json:"shipping_cost"`
+    MarketplaceFee    Money  `json:"marketplace_fee"`
+    TaxesAmount       Money  `json:"taxes_amount"`
+    CouponAmount      Money  `json:"coupon_amount"`
+    OverpaidAmount    Money  `json:"overpaid_amount"`
+    CurrencyId        string `json:"currency_id"`
+    PaymentMethodId   string `json:"payment_method_id"`
+    PaymentType       string `json:"payment_type"`
+    Installments      int    `json:"installments"`
+    DateCreated       string `json:"date_created"`
+    DateApproved      string `json:"date_approved"`
+    MoneyReleaseDate  string `json:"money_release_date"`
+}
+
+/*
+Returns the amount the seller receives: what the buyer paid minus the marketplace fee and the shipping cost.
+*/
+func (p *Payment) NetAmount() Money {
+    return p.TotalPaidAmount - p.MarketplaceFee - p.ShippingCost
+}
+
+//Returns when the money of the payment will be available, and false when it is not known yet.
+func (p *Payment) ReleaseDate() (time.Time, bool) {
+
+    date, err := time.Parse(time.RFC3339, p.MoneyReleaseDate)
+
+    if err != nil {
+        return date, false
+    }
+
+    return date, true
+}
+
+/*
+Payments gives typed access to the payments of the orders.
+*/
+type Payments struct {
+    client *Client
+}
+
+func (client *Client) Payments() *Payments {
+    return &Payments{client: client}
+}
+
+func (p *Payments) Get(paymentId int64) (*Payment, error) {
+
+    var collection struct {
+        Collection Payment `json:"collection"`
+    }
+
+    if err := p.client.NewRequest(http.MethodGet, "/collections/"+strconv.FormatInt(paymentId, 10)).Decode(&collection); err != nil {
+        return nil, err
+    }
+
+    return &collection.Collection, nil
+}
+
+//Fetches the detail of every payment referenced by the order.
+func (p *Payments) ForOrder(order *Order) ([]Payment, error) {
+
+    payments := make([]Payment, 0, len(order.Payments))
+
+    for _, reference := range order.Payments {
+
+        payment, err := p.Get(reference.Id)
+
+        if err != nil {
+            return nil, err
+        }
+
+        payments = append(payments, *payment)
+    }
+
+    return payments, nil
+}
+
+/*
+ReconciliationLine compares an order against its payments. Paid, Fees, Shipping and Net only count approved payments.
+*/
+type ReconciliationLine struct {
+    OrderId     int64
+    Status      string
+    CurrencyId  string
+    OrderAmount Money
+    Paid        Money
+    Fees        Money
+    Shipping    Money
+    Net         Money
+    ReleaseDate time.Time
+    PaymentIds  []int64
+}
+
+type ReconciliationReport struct {
+    From  time.Time
+    To    time.Time
+    Lines []ReconciliationLine
+}
+
+//Returns the lines which are not reconciled.
+func (r *ReconciliationReport) Mismatches() []ReconciliationLine {
+
+    var lines []ReconciliationLine
+
+    for _, line := range r.Lines {
+        if line.Status != RECONCILED {
+            lines = append(lines, line)
+        }
+    }
+
+    return lines
+}
+
+//Returns the net amount of the reconciled lines, per currency.
+func (r *ReconciliationReport) NetByCurrency() map[string]Money {
+
+    totals := map[string]Money{}
+
+    for _, line := range r.Lines {
+        if line.Status == RECONCILED {
+            totals[line.CurrencyId] += line.Net
+        }
+    }
+
+    return totals
+}
+
+/*
+This method compares the orders of the seller created between from and to against their payments.
+*/
+func (p *Payments) Reconcile(sellerId int64, from time.Time, to time.Time) (*ReconciliationReport, error) {
+
+    orders, err := p.client.Orders().Between(sellerId, from, to)
+
+    if err != nil {
+        return nil, err
+    }
+
+    report := &ReconciliationReport{From: from, To: to, Lines: make([]ReconciliationLine, 0, len(orders))}
+
+    for i := range orders {
+
+        payments, err := p.ForOrder(&orders[i])
+
+        if err != nil {
+            return nil, err
+        }
+
+        report.Lines = append(report.Lines, reconcile(&orders[i], payments))
+    }
+
+    return report, nil
+}
+
+func reconcile(order *Order, payments []Payment) ReconciliationLine {
+
+    line := ReconciliationLine{OrderId: order.Id, CurrencyId: order.CurrencyId, OrderAmount: order.TotalAmount}
+    refunded := false
+
+    for _, payment := range payments {
+
+        line.PaymentIds = append(line.PaymentIds, payment.Id)
+
+        switch payment.Status {
+        case PAYMENT_APPROVED:
+            line.Paid += payment.TransactionAmount
+            line.Fees += payment.MarketplaceFee
+            line.Shipping += payment.ShippingCost
+            line.Net += payment.NetAmount()
+
+            if release, ok := payment.ReleaseDate(); ok && release.After(line.ReleaseDate) {
+                line.ReleaseDate = release
+            }
+        case PAYMENT_REFUNDED, PAYMENT_CHARGED_BACK:
+            refunded = true
+        }
+    }
+
+    switch {
+    case refunded:
+        line.Status = RECONCILE_REFUNDED
+    case line.Paid == 0:
+        line.Status = RECONCILE_MISSING_PAYMENT
+    case (line.Paid - line.OrderAmount).Abs() > RECONCILE_TOLERANCE:
+        line.Status = RECONCILE_AMOUNT_MISMATCH
+    default:
+        line.Status = RECONCILED
+    }
+
+    return line
+}
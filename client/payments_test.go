@@ -0,0 +1,74 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "time"
+)
+
+func Test_orders_are_reconciled_against_their_payments(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/orders/search":
+            w.Write([]byte("{\"paging\":{\"total\":4,\"offset\":0,\"limit\":50},\"results\":[" +
+                "{\"id\":1,\"total_amount\":100,\"currency_id\":\"ARS\",\"payments\":[{\"id\":11}]}," +
+                "{\"id\":2,\"total_amount\":100,\"currency_id\":\"ARS\",\"payments\":[{\"id\":21}]}," +
+                "{\"id\":3,\"total_amount\":100,\"currency_id\":\"ARS\",\"payments\":[]}," +
+                "{\"id\":4,\"total_amount\":100,\"currency_id\":\"ARS\",\"payments\":[{\"id\":41}]}]}"))
+        case "/collections/11":
+            w.Write([]byte("{\"collection\":{\"id\":11,\"status\":\"approved\",\"transaction_amount\":100,\"total_paid_amount\":120," +
+                "\"shipping_cost\":20,\"marketplace_fee\":13,\"money_release_date\":\"2016-06-20T10:00:00.000-04:00\"}}"))
+        case "/collections/21":
+            w.Write([]byte("{\"collection\":{\"id\":21,\"status\":\"approved\",\"transaction_amount\":90,\"total_paid_amount\":90}}"))
+        case "/collections/41":
+            w.Write([]byte("{\"collection\":{\"id\":41,\"status\":\"refunded\",\"transaction_amount\":100}}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    report, err := client.Payments().Reconcile(2, time.Now().AddDate(0, -1, 0), time.Now())
+
+    if err != nil || len(report.Lines) != 4 {
+        log.Printf("unexpected report %v %v\n", report, err)
+        t.FailNow()
+    }
+
+    expected := []string{RECONCILED, RECONCILE_AMOUNT_MISMATCH, RECONCILE_MISSING_PAYMENT, RECONCILE_REFUNDED}
+    for i, line := range report.Lines {
+        if line.Status != expected[i] {
+            log.Printf("order %d should be %s but is %s\n", line.OrderId, expected[i], line.Status)
+            t.FailNow()
+        }
+    }
+
+    first := report.Lines[0]
+    if first.Net != MoneyFromInt(87) || first.Fees != MoneyFromInt(13) || first.ReleaseDate.IsZero() {
+        log.Printf("unexpected net amount %v\n", first)
+        t.FailNow()
+    }
+
+    if len(report.Mismatches()) != 3 || report.NetByCurrency()["ARS"] != MoneyFromInt(87) {
+        log.Printf("unexpected totals %v\n", report.NetByCurrency())
+        t.FailNow()
+    }
+}
@@ -0,0 +1,168 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "bytes"
+    "fmt"
+    "io"
+    "log"
+    "net/http"
+    "os"
+    "path/filepath"
+)
+
+const (
+    MAX_PICTURE_SIZE = 10 * 1024 * 1024 // 10 MB is the biggest picture accepted by ML
+
+    picturesUploadPath = "/pictures/items/upload"
+)
+
+//Formats accepted by ML for item pictures.
+var allowedPictureFormats = map[string]bool{
+    "image/jpeg": true,
+    "image/png":  true,
+    "image/gif":  true,
+    "image/webp": true,
+}
+
+var ErrPictureTooLarge = fmt.Errorf("The picture is bigger than %d bytes.", MAX_PICTURE_SIZE)
+
+/*
+Picture is returned by ML after uploading an image. The Id has to be used when linking the picture to an item.
+*/
+type Picture struct {
+    Id         string             `json:"id"`
+    MaxSize    string             `json:"max_size"`
+    Variations []PictureVariation `json:"variations"`
+}
+
+type PictureVariation struct {
+    Size      string `json:"size"`
+    Url       string `json:"url"`
+    SecureUrl string `json:"secure_url"`
+}
+
+/*
+ProgressFunc is called while a picture is being uploaded with the amount of bytes sent so far.
+total is -1 when the size of the picture is unknown.
+*/
+type ProgressFunc func(sent int64, total int64)
+
+/*
+This method uploads the picture stored in the given file. progress may be nil.
+*/
+func (client *Client) UploadPicture(fileName string, progress ProgressFunc) (*Picture, error) {
+
+    file, err := os.Open(fileName)
+
+    if err != nil {
+        log.Printf("Error while opening picture %s: %s\n", fileName, err)
+        return nil, err
+    }
+    defer file.Close()
+
+    info, err := file.Stat()
+
+    if err != nil {
+        return nil, err
+    }
+
+    return client.UploadPictureReader(filepath.Base(fileName), file, info.Size(), progress)
+}
+
+/*
+This method streams the picture read from reader as multipart/form-data.
+size is used to check the limits before sending; use -1 when it is unknown, and the limit will be checked while streaming.
+*/
+func (client *Client) UploadPictureReader(name string, reader io.Reader, size int64, progress ProgressFunc) (*Picture, error) {
+
+    if size > MAX_PICTURE_SIZE {
+        return nil, ErrPictureTooLarge
+    }
+
+    head := make([]byte, 512)
+    n, err := io.ReadFull(reader, head)
+
+    if err != nil && err != io.ErrUnexpectedEOF {
+        log.Printf("Error while reading picture %s: %s\n", name, err)
+        return nil, err
+    }
+    head = head[:n]
+
+    format := http.DetectContentType(head)
+
+    if !allowedPictureFormats[format] {
+        return nil, fmt.Errorf("The picture format %s is not allowed.", format)
+    }
+
+    content := &uploadReader{
+        reader:   io.MultiReader(bytes.NewReader(head), reader),
+        total:    size,
+        max:      MAX_PICTURE_SIZE,
+        tooLarge: ErrPictureTooLarge,
+        progress: progress,
+    }
+
+    picture := new(Picture)
+    err = client.NewRequest(http.MethodPost, picturesUploadPath).Multipart("file", name, content).Decode(picture)
+
+    if err != nil {
+        log.Printf("Error while uploading picture %s: %s\n", name, err)
+        return nil, err
+    }
+
+    return picture, nil
+}
+
+/*
+This method links an already uploaded picture to an item.
+*/
+func (client *Client) AddPictureToItem(itemId string, pictureId string) error {
+
+    body := struct {
+        Id string `json:"id"`
+    }{Id: pictureId}
+
+    return client.NewRequest(http.MethodPost, "/items/"+itemId+"/pictures").JSON(body).Decode(nil)
+}
+
+//Counts the bytes read for reporting the progress and enforcing the size limit of uploads.
+type uploadReader struct {
+    reader   io.Reader
+    sent     int64
+    total    int64
+    max      int64
+    tooLarge error
+    progress ProgressFunc
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+
+    n, err := r.reader.Read(p)
+    r.sent += int64(n)
+
+    if r.sent > r.max {
+        return n, r.tooLarge
+    }
+
+    if r.progress != nil && n > 0 {
+        r.progress(r.sent, r.total)
+    }
+
+    return n, err
+}
@@ -0,0 +1,97 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "bytes"
+    "io/ioutil"
+    "strings"
+)
+
+//Smallest valid GIF, enough for the format to be detected.
+var testGif = []byte("GIF89a\x01\x00\x01\x00\x80\x00\x00\xff\xff\xff\x00\x00\x00!\xf9\x04\x01\x00\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02D\x01\x00;")
+
+func Test_picture_is_uploaded_as_multipart_and_returns_its_id(t *testing.T) {
+
+    var received []byte
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path != "/pictures/items/upload" {
+            w.WriteHeader(http.StatusNotFound)
+            return
+        }
+        file, _, err := r.FormFile("file")
+        if err != nil {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        received, _ = ioutil.ReadAll(file)
+        w.Write([]byte("{\"id\":\"123-MLA456_789\",\"max_size\":\"1x1\",\"variations\":[{\"size\":\"1x1\",\"url\":\"http://pic\"}]}"))
+    })
+    defer server.Close()
+
+    var progress int64
+    picture, err := client.UploadPictureReader("pic.gif", bytes.NewReader(testGif), int64(len(testGif)), func(sent int64, total int64) {
+        progress = sent
+    })
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    if picture.Id != "123-MLA456_789" || len(picture.Variations) != 1 {
+        log.Printf("unexpected picture %v\n", picture)
+        t.FailNow()
+    }
+
+    if !bytes.Equal(received, testGif) || progress != int64(len(testGif)) {
+        log.Printf("picture was not properly sent. progress: %d\n", progress)
+        t.FailNow()
+    }
+}
+
+func Test_picture_upload_fails_before_sending_when_format_is_not_allowed(t *testing.T) {
+
+    called := false
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        called = true
+    })
+    defer server.Close()
+
+    _, err := client.UploadPictureReader("notes.txt", strings.NewReader("just some text"), -1, nil)
+
+    if err == nil || called {
+        log.Printf("upload should have failed before calling the API\n")
+        t.FailNow()
+    }
+}
+
+func Test_picture_upload_fails_before_sending_when_it_is_too_large(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {})
+    defer server.Close()
+
+    _, err := client.UploadPictureReader("big.gif", bytes.NewReader(testGif), MAX_PICTURE_SIZE + 1, nil)
+
+    if err != ErrPictureTooLarge {
+        log.Printf("unexpected error %v\n", err)
+        t.FailNow()
+    }
+}
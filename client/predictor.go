@@ -0,0 +1,85 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "net/http"
+    "strconv"
+)
+
+const DEFAULT_PREDICTION_LIMIT = 5
+
+/*
+CategoryCandidate is one of the categories predicted for a title. Attributes are the values ML could infer from the title
+(for instance the brand), while RequiredAttributes are the ones the category needs for listing.
+*/
+type CategoryCandidate struct {
+    DomainId           string          `json:"domain_id"`
+    DomainName         string          `json:"domain_name"`
+    CategoryId         string          `json:"category_id"`
+    CategoryName       string          `json:"category_name"`
+    Attributes         []ItemAttribute `json:"attributes"`
+    RequiredAttributes []Attribute     `json:"-"`
+}
+
+/*
+This method sets the category of the candidate to the item, together with the attribute values inferred from the title.
+Attributes already set in the item are kept.
+*/
+func (candidate *CategoryCandidate) ApplyTo(item *Item) {
+
+    item.CategoryId = candidate.CategoryId
+
+    for _, attribute := range candidate.Attributes {
+        if item.Attribute(attribute.Id) == nil {
+            item.Attributes = append(item.Attributes, attribute)
+        }
+    }
+}
+
+/*
+This method predicts the categories for an item title through the domain discovery API of the site.
+Candidates are ranked from the most to the least probable one. limit <= 0 takes the default.
+*/
+func (c *Categories) Predict(siteId string, title string, limit int) ([]CategoryCandidate, error) {
+
+    if limit <= 0 {
+        limit = DEFAULT_PREDICTION_LIMIT
+    }
+
+    var candidates []CategoryCandidate
+
+    err := c.client.NewRequest(http.MethodGet, "/sites/"+siteId+"/domain_discovery/search").
+        Query("q", title).
+        Query("limit", strconv.Itoa(limit)).
+        Decode(&candidates)
+
+    if err != nil {
+        return nil, err
+    }
+
+    for i := range candidates {
+        required, err := c.RequiredAttributes(candidates[i].CategoryId)
+
+        if err != nil {
+            return nil, err
+        }
+        candidates[i].RequiredAttributes = required
+    }
+
+    return candidates, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "encoding/json"
+)
+
+func newTestPredictorClient(posted *Item) (*Client, func()) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/sites/MLA/domain_discovery/search":
+            if r.URL.Query().Get("q") != "Anteojos Ray-Ban Wayfarer" {
+                w.WriteHeader(http.StatusBadRequest)
+                return
+            }
+            w.Write([]byte("[{\"domain_id\":\"MLA-SUNGLASSES\",\"category_id\":\"MLA1912\",\"category_name\":\"Anteojos\",\"attributes\":[{\"id\":\"BRAND\",\"value_id\":\"1\",\"value_name\":\"Ray-Ban\"}]}," +
+                "{\"domain_id\":\"MLA-GLASSES\",\"category_id\":\"MLA1913\",\"category_name\":\"Lentes\"}]"))
+        case "/categories/MLA1912/attributes", "/categories/MLA1913/attributes":
+            w.Write([]byte("[{\"id\":\"BRAND\",\"tags\":{\"required\":true}},{\"id\":\"MODEL\",\"tags\":{\"required\":true}},{\"id\":\"COLOR\"}]"))
+        case "/items":
+            json.NewDecoder(r.Body).Decode(posted)
+            w.WriteHeader(http.StatusCreated)
+            w.Write([]byte("{\"id\":\"MLA123\"}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+
+    CategoriesCache.Clear()
+    return client, server.Close
+}
+
+func Test_predict_returns_ranked_candidates_with_their_required_attributes(t *testing.T) {
+
+    client, closeServer := newTestPredictorClient(nil)
+    defer closeServer()
+
+    candidates, err := client.Categories().Predict("MLA", "Anteojos Ray-Ban Wayfarer", 2)
+
+    if err != nil || len(candidates) != 2 || candidates[0].CategoryId != "MLA1912" {
+        log.Printf("unexpected candidates %v %v\n", candidates, err)
+        t.FailNow()
+    }
+
+    if len(candidates[0].RequiredAttributes) != 2 {
+        log.Printf("unexpected required attributes %v\n", candidates[0].RequiredAttributes)
+        t.FailNow()
+    }
+}
+
+func Test_creating_an_item_without_category_uses_the_predicted_one(t *testing.T) {
+
+    posted := new(Item)
+    client, closeServer := newTestPredictorClient(posted)
+    defer closeServer()
+
+    attributes := make([]ItemAttribute, 1, 2)
+    attributes[0] = ItemAttribute{Id:"MODEL", ValueName:"RB2140"}
+    item := &Item{SiteId:"MLA", Title:"Anteojos Ray-Ban Wayfarer", Attributes:attributes}
+
+    created, err := client.Items().Create(item)
+
+    if err != nil || created.Id != "MLA123" {
+        log.Printf("unexpected item %v %v\n", created, err)
+        t.FailNow()
+    }
+
+    if posted.CategoryId != "MLA1912" || posted.Attribute("BRAND") == nil || posted.Attribute("MODEL").ValueName != "RB2140" {
+        log.Printf("the prediction was not applied to the item %v\n", posted)
+        t.FailNow()
+    }
+
+    if item.CategoryId != "" || len(item.Attributes) != 1 || attributes[:2][1].Id != "" {
+        log.Printf("the given item should not be changed %v\n", item)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,298 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "net/url"
+    "strconv"
+    "time"
+)
+
+const (
+    PROMOTION_PRICE_DISCOUNT = "PRICE_DISCOUNT"
+    PROMOTION_DEAL           = "DEAL"
+    PROMOTION_LIGHTNING      = "LIGHTNING"
+    PROMOTION_DOD            = "DOD"
+
+    PROMOTION_CANDIDATE = "candidate"
+    PROMOTION_PENDING   = "pending"
+    PROMOTION_STARTED   = "started"
+    PROMOTION_FINISHED  = "finished"
+
+    //Limits of the discounts funded by the seller.
+    MIN_DISCOUNT_PERCENTAGE = 5
+    MAX_DISCOUNT_PERCENTAGE = 80
+    MAX_DISCOUNT_DAYS       = 14
+
+    //Layout of the dates used by the promotions API. The offset keeps the instant of dates outside UTC.
+    PROMOTION_DATE_LAYOUT = "2006-01-02T15:04:05Z07:00"
+
+    promotionsVersion = "v2"
+)
+
+type Promotion struct {
+    Id            string `json:"id"`
+    Type          string `json:"type"`
+    Status        string `json:"status"`
+    Name          string `json:"name"`
+    StartDate     string `json:"start_date"`
+    FinishDate    string `json:"finish_date"`
+    DeadlineDate  string `json:"deadline_date"`
+    Price         Money  `json:"price,omitempty"`
+    OriginalPrice Money  `json:"original_price,omitempty"`
+}
+
+/*
+PromotionItem is an item of a promotion. Candidates may join it with a price between MinDiscountedPrice and
+MaxDiscountedPrice, when the promotion sets them.
+*/
+type PromotionItem struct {
+    Id                       string `json:"id"`
+    Status                   string `json:"status"`
+    Price                    Money  `json:"price"`
+    OriginalPrice            Money  `json:"original_price"`
+    MinDiscountedPrice       Money  `json:"min_discounted_price"`
+    MaxDiscountedPrice       Money  `json:"max_discounted_price"`
+    SuggestedDiscountedPrice Money  `json:"suggested_discounted_price"`
+}
+
+//Page decoded by the Pagers of Promotions.List.
+type PromotionSearch struct {
+    Paging  Paging      `json:"paging"`
+    Results []Promotion `json:"results"`
+}
+
+//Page decoded by the Pagers of Promotions.Items.
+type PromotionItems struct {
+    Paging  Paging          `json:"paging"`
+    Results []PromotionItem `json:"results"`
+}
+
+/*
+PriceDiscount is a discount funded by the seller. TopDealPrice is an optional lower price for the best buyers.
+*/
+type PriceDiscount struct {
+    DealPrice    Money
+    TopDealPrice Money
+    StartDate    time.Time
+    FinishDate   time.Time
+}
+
+//Returns the percentage price is below original, for instance 20 for 80 over 100.
+func DiscountPercentage(original Money, price Money) float64 {
+
+    if original <= 0 {
+        return 0
+    }
+
+    return 100 * float64(original-price) / float64(original)
+}
+
+func checkDiscountPercentage(original Money, price Money) error {
+
+    percentage := DiscountPercentage(original, price)
+
+    if percentage < MIN_DISCOUNT_PERCENTAGE || percentage > MAX_DISCOUNT_PERCENTAGE {
+        return fmt.Errorf("The discount from %s to %s is %.2f%%, but it has to be between %d%% and %d%%.",
+            original, price, percentage, MIN_DISCOUNT_PERCENTAGE, MAX_DISCOUNT_PERCENTAGE)
+    }
+
+    return nil
+}
+
+/*
+This function checks the discount of an item whose price is original: the discounted prices have to be between
+MIN_DISCOUNT_PERCENTAGE and MAX_DISCOUNT_PERCENTAGE below it, and the discount has to finish after it starts, in the
+future and within MAX_DISCOUNT_DAYS.
+*/
+func CheckDiscount(original Money, discount PriceDiscount, now time.Time) error {
+
+    if err := checkDiscountPercentage(original, discount.DealPrice); err != nil {
+        return err
+    }
+
+    if discount.TopDealPrice != 0 {
+
+        if discount.TopDealPrice >= discount.DealPrice {
+            return errors.New("The top deal price has to be lower than the deal price.")
+        }
+
+        if err := checkDiscountPercentage(original, discount.TopDealPrice); err != nil {
+            return err
+        }
+    }
+
+    if !discount.FinishDate.After(discount.StartDate) {
+        return errors.New("The discount has to finish after it starts.")
+    }
+
+    if !discount.FinishDate.After(now) {
+        return errors.New("The discount finishes in the past.")
+    }
+
+    if discount.FinishDate.Sub(discount.StartDate) > MAX_DISCOUNT_DAYS*24*time.Hour {
+        return fmt.Errorf("The discount may last at most %d days.", MAX_DISCOUNT_DAYS)
+    }
+
+    return nil
+}
+
+/*
+Promotions gives typed access to the promotions and deals funded by the seller.
+*/
+type Promotions struct {
+    client *Client
+}
+
+func (client *Client) Promotions() *Promotions {
+    return &Promotions{client: client}
+}
+
+func promotionItemPath(itemId string) string {
+    return "/seller-promotions/items/" + itemId
+}
+
+//Returns a Pager over the promotions the seller was invited to. Each page is decoded into a PromotionSearch.
+func (p *Promotions) List(userId int64, limit int) *Pager {
+
+    params := url.Values{}
+    params.Set("app_version", promotionsVersion)
+
+    return p.client.NewPager("/seller-promotions/users/"+strconv.FormatInt(userId, 10), params, limit)
+}
+
+func (p *Promotions) Get(promotionId string, promotionType string) (*Promotion, error) {
+
+    promotion := new(Promotion)
+
+    err := p.client.NewRequest(http.MethodGet, "/seller-promotions/promotions/"+promotionId).
+        Query("promotion_type", promotionType).
+        Query("app_version", promotionsVersion).
+        Decode(promotion)
+
+    if err != nil {
+        return nil, err
+    }
+
+    return promotion, nil
+}
+
+/*
+This method returns a Pager over the items of the promotion with the given status, for instance PROMOTION_CANDIDATE
+for the eligible ones; an empty status does not filter. Each page is decoded into PromotionItems.
+*/
+func (p *Promotions) Items(promotionId string, promotionType string, status string, limit int) *Pager {
+
+    params := url.Values{}
+    params.Set("promotion_type", promotionType)
+    params.Set("app_version", promotionsVersion)
+
+    if status != "" {
+        params.Set("status", status)
+    }
+
+    return p.client.NewPager("/seller-promotions/promotions/"+promotionId+"/items", params, limit)
+}
+
+//Returns the promotions of the item, with their status and price.
+func (p *Promotions) ForItem(itemId string) ([]Promotion, error) {
+
+    var promotions []Promotion
+    err := p.client.NewRequest(http.MethodGet, promotionItemPath(itemId)).Query("app_version", promotionsVersion).Decode(&promotions)
+
+    return promotions, err
+}
+
+/*
+This method creates a discount funded by the seller for the item, whose current price is original.
+The discount is checked with CheckDiscount before sending it.
+*/
+func (p *Promotions) CreateDiscount(itemId string, original Money, discount PriceDiscount) error {
+
+    if err := CheckDiscount(original, discount, time.Now()); err != nil {
+        return err
+    }
+
+    body := struct {
+        PromotionType string `json:"promotion_type"`
+        DealPrice     Money  `json:"deal_price"`
+        TopDealPrice  Money  `json:"top_deal_price,omitempty"`
+        StartDate     string `json:"start_date"`
+        FinishDate    string `json:"finish_date"`
+    }{
+        PromotionType: PROMOTION_PRICE_DISCOUNT,
+        DealPrice:     discount.DealPrice,
+        TopDealPrice:  discount.TopDealPrice,
+        StartDate:     discount.StartDate.Format(PROMOTION_DATE_LAYOUT),
+        FinishDate:    discount.FinishDate.Format(PROMOTION_DATE_LAYOUT),
+    }
+
+    return p.client.NewRequest(http.MethodPost, promotionItemPath(itemId)).Query("app_version", promotionsVersion).JSON(body).Decode(nil)
+}
+
+func (p *Promotions) RemoveDiscount(itemId string) error {
+
+    return p.client.NewRequest(http.MethodDelete, promotionItemPath(itemId)).
+        Query("promotion_type", PROMOTION_PRICE_DISCOUNT).
+        Query("app_version", promotionsVersion).
+        Decode(nil)
+}
+
+/*
+This method joins a candidate item to a deal with the given price. The price is checked against the range
+allowed by the deal, when it sets one, and against the discount percentage limits.
+*/
+func (p *Promotions) JoinDeal(promotionId string, promotionType string, item PromotionItem, dealPrice Money) error {
+
+    if item.Status != "" && item.Status != PROMOTION_CANDIDATE {
+        return fmt.Errorf("Item %s is not a candidate of promotion %s.", item.Id, promotionId)
+    }
+
+    if item.MinDiscountedPrice > 0 && dealPrice < item.MinDiscountedPrice || item.MaxDiscountedPrice > 0 && dealPrice > item.MaxDiscountedPrice {
+        return fmt.Errorf("The price of the deal has to be between %s and %s.", item.MinDiscountedPrice, item.MaxDiscountedPrice)
+    }
+
+    original := item.OriginalPrice
+    if original == 0 {
+        original = item.Price
+    }
+
+    if err := checkDiscountPercentage(original, dealPrice); err != nil {
+        return err
+    }
+
+    body := struct {
+        PromotionId   string `json:"promotion_id"`
+        PromotionType string `json:"promotion_type"`
+        DealPrice     Money  `json:"deal_price"`
+    }{PromotionId: promotionId, PromotionType: promotionType, DealPrice: dealPrice}
+
+    return p.client.NewRequest(http.MethodPost, promotionItemPath(item.Id)).Query("app_version", promotionsVersion).JSON(body).Decode(nil)
+}
+
+//Removes the item from the deal.
+func (p *Promotions) LeaveDeal(promotionId string, promotionType string, itemId string) error {
+
+    return p.client.NewRequest(http.MethodDelete, promotionItemPath(itemId)).
+        Query("promotion_id", promotionId).
+        Query("promotion_type", promotionType).
+        Query("app_version", promotionsVersion).
+        Decode(nil)
+}
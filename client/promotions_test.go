@@ -0,0 +1,109 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "encoding/json"
+    "time"
+)
+
+func Test_discounts_are_checked_before_creating_them(t *testing.T) {
+
+    var received map[string]interface{}
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.Method != http.MethodPost || r.URL.Path != "/seller-promotions/items/MLA1" || r.URL.Query().Get("app_version") != "v2" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        json.NewDecoder(r.Body).Decode(&received)
+    })
+    defer server.Close()
+
+    start := time.Now().Add(time.Hour)
+    original := MoneyFromInt(100)
+
+    invalid := []PriceDiscount{
+        {DealPrice: MoneyFromInt(98), StartDate: start, FinishDate: start.AddDate(0, 0, 7)},
+        {DealPrice: MoneyFromInt(10), StartDate: start, FinishDate: start.AddDate(0, 0, 7)},
+        {DealPrice: MoneyFromInt(80), TopDealPrice: MoneyFromInt(85), StartDate: start, FinishDate: start.AddDate(0, 0, 7)},
+        {DealPrice: MoneyFromInt(80), StartDate: start, FinishDate: start.Add(-time.Minute)},
+        {DealPrice: MoneyFromInt(80), StartDate: start, FinishDate: start.AddDate(0, 0, MAX_DISCOUNT_DAYS+1)},
+    }
+
+    for _, discount := range invalid {
+        if err := client.Promotions().CreateDiscount("MLA1", original, discount); err == nil {
+            log.Printf("the discount %v should not be created\n", discount)
+            t.FailNow()
+        }
+    }
+
+    start = start.In(time.FixedZone("ART", -3*60*60))
+    discount := PriceDiscount{DealPrice: MoneyFromFloat(79.9), TopDealPrice: MoneyFromInt(75), StartDate: start, FinishDate: start.AddDate(0, 0, 7)}
+
+    if err := client.Promotions().CreateDiscount("MLA1", original, discount); err != nil || received["deal_price"] != 79.9 || received["promotion_type"] != PROMOTION_PRICE_DISCOUNT {
+        log.Printf("unexpected discount %v %v\n", received, err)
+        t.FailNow()
+    }
+
+    if sent, err := time.Parse(time.RFC3339, received["start_date"].(string)); err != nil || !sent.Equal(start.Truncate(time.Second)) {
+        log.Printf("the start date should keep its offset %v %v\n", received["start_date"], err)
+        t.FailNow()
+    }
+}
+
+func Test_candidates_join_deals_within_the_allowed_prices(t *testing.T) {
+
+    var received map[string]interface{}
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch {
+        case r.Method == http.MethodGet && r.URL.Path == "/seller-promotions/promotions/P-MLA1/items":
+            if r.URL.Query().Get("status") != PROMOTION_CANDIDATE || r.URL.Query().Get("promotion_type") != PROMOTION_DEAL {
+                w.WriteHeader(http.StatusBadRequest)
+                return
+            }
+            w.Write([]byte("{\"paging\":{\"total\":1,\"offset\":0,\"limit\":50},\"results\":[{\"id\":\"MLA1\",\"status\":\"candidate\"," +
+                "\"price\":100,\"min_discounted_price\":60,\"max_discounted_price\":90}]}"))
+        case r.Method == http.MethodPost && r.URL.Path == "/seller-promotions/items/MLA1":
+            json.NewDecoder(r.Body).Decode(&received)
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    var page PromotionItems
+
+    if err := client.Promotions().Items("P-MLA1", PROMOTION_DEAL, PROMOTION_CANDIDATE, 0).Next(&page); err != nil || len(page.Results) != 1 {
+        log.Printf("unexpected candidates %v %v\n", page, err)
+        t.FailNow()
+    }
+
+    item := page.Results[0]
+
+    if err := client.Promotions().JoinDeal("P-MLA1", PROMOTION_DEAL, item, MoneyFromInt(95)); err == nil {
+        log.Printf("prices above the allowed range should fail\n")
+        t.FailNow()
+    }
+
+    if err := client.Promotions().JoinDeal("P-MLA1", PROMOTION_DEAL, item, MoneyFromInt(85)); err != nil || received["promotion_id"] != "P-MLA1" || received["deal_price"] != 85.0 {
+        log.Printf("unexpected deal %v %v\n", received, err)
+        t.FailNow()
+    }
+}
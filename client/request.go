@@ -0,0 +1,321 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "log"
+    "mime/multipart"
+    "net/http"
+    "net/url"
+    "strings"
+)
+
+/*
+Request describes a single call to the ML API before it is sent.
+It is created through Client.NewRequest and allows adding query params, headers and a body.
+Every HTTP method of the Client ends up calling Request.Do, so the token handling is the same for all of them.
+*/
+type Request struct {
+    client *Client
+    method string
+    path   string
+    query  url.Values
+    header http.Header
+    body   io.Reader
+    err    error
+}
+
+/*
+ApiError is returned when the ML API answers with a status code greater or equal than 400.
+*/
+type ApiError struct {
+    Message    string        `json:"message"`
+    ErrorCode  string        `json:"error"`
+    Status     int           `json:"status"`
+    Cause      []interface{} `json:"cause"`
+    StatusCode int           `json:"-"`
+}
+
+func (e *ApiError) Error() string {
+    if e.Message == "" {
+        return fmt.Sprintf("ML API returned status code %d", e.StatusCode)
+    }
+    return fmt.Sprintf("ML API returned status code %d: %s (%s)", e.StatusCode, e.Message, e.ErrorCode)
+}
+
+/*
+This method creates a new Request for the given HTTP method and resource path.
+The resource path may already contain query params, they will be kept.
+*/
+func (client *Client) NewRequest(method string, resourcePath string) *Request {
+
+    return &Request{
+        client: client,
+        method: method,
+        path:   resourcePath,
+        query:  url.Values{},
+        header: http.Header{},
+    }
+}
+
+//Adds a query param. It may be called several times with the same key.
+func (r *Request) Query(key string, value string) *Request {
+    r.query.Add(key, value)
+    return r
+}
+
+//Adds all the given query params.
+func (r *Request) Params(values url.Values) *Request {
+    for key, list := range values {
+        for _, value := range list {
+            r.query.Add(key, value)
+        }
+    }
+    return r
+}
+
+//Sets a header. Any previous value for the same header is replaced.
+func (r *Request) Header(key string, value string) *Request {
+    r.header.Set(key, value)
+    return r
+}
+
+/*
+Sets the body to be sent. When no Content-Type header is set, application/json is assumed.
+*/
+func (r *Request) Body(body io.Reader) *Request {
+    r.body = body
+    return r
+}
+
+/*
+Sets the body to be sent as the JSON encoding of v.
+*/
+func (r *Request) JSON(v interface{}) *Request {
+
+    content, err := json.Marshal(v)
+
+    if err != nil {
+        log.Printf("Error while encoding the request body: %s\n", err)
+        r.err = err
+        return r
+    }
+
+    r.body = bytes.NewReader(content)
+    r.header.Set("Content-Type", "application/json")
+    return r
+}
+
+/*
+Sets the body as multipart/form-data with a single file, which is streamed while the request is sent.
+*/
+func (r *Request) Multipart(field string, fileName string, content io.Reader) *Request {
+
+    bodyReader, bodyWriter := io.Pipe()
+    form := multipart.NewWriter(bodyWriter)
+
+    go func() {
+        part, err := form.CreateFormFile(field, fileName)
+
+        if err == nil {
+            _, err = io.Copy(part, content)
+        }
+
+        if err == nil {
+            err = form.Close()
+        }
+
+        bodyWriter.CloseWithError(err)
+    }()
+
+    r.header.Set("Content-Type", form.FormDataContentType())
+    r.body = bodyReader
+    return r
+}
+
+/*
+This method sends the request to the ML API. If the token has expired, it is refreshed before sending the request.
+*/
+func (r *Request) Do() (*http.Response, error) {
+
+    if r.err != nil {
+        r.closeBody()
+        return nil, r.err
+    }
+
+    apiUrl, err := r.client.getAuthorizedURL(r.path, r.query)
+
+    if err != nil {
+        log.Printf("Error while refreshing token")
+        r.closeBody()
+        return nil, err
+    }
+
+    req, err := http.NewRequest(r.method, apiUrl, r.body)
+
+    if err != nil {
+        log.Printf("Error when creating %s request %s.", r.method, err)
+        r.closeBody()
+        return nil, err
+    }
+
+    for key, values := range r.header {
+        req.Header[key] = values
+    }
+
+    if r.body != nil && req.Header.Get("Content-Type") == "" {
+        req.Header.Set("Content-Type", "application/json")
+    }
+
+    resp, err := r.client.httpClient().Do(req)
+
+    if err != nil {
+        log.Printf("Error while calling url: %s Error: %s\n", apiUrl, err)
+        return nil, err
+    }
+
+    return resp, nil
+}
+
+//The body is closed when the request could not be sent, so that streamed bodies are released.
+func (r *Request) closeBody() {
+    if closer, ok := r.body.(io.Closer); ok {
+        closer.Close()
+    }
+}
+
+/*
+This method sends the request and decodes the JSON response into v.
+When the ML API answers with an error status code, an *ApiError is returned.
+v may be nil when the response body is not needed.
+*/
+func (r *Request) Decode(v interface{}) error {
+
+    resp, err := r.Do()
+
+    if err != nil {
+        return err
+    }
+
+    return decodeResponse(resp, v)
+}
+
+func decodeResponse(resp *http.Response, v interface{}) error {
+
+    defer resp.Body.Close()
+
+    body, err := ioutil.ReadAll(resp.Body)
+
+    if err != nil {
+        return err
+    }
+
+    if resp.StatusCode >= http.StatusBadRequest {
+        apiErr := &ApiError{StatusCode: resp.StatusCode}
+        json.Unmarshal(body, apiErr)
+        return apiErr
+    }
+
+    if v == nil || len(bytes.TrimSpace(body)) == 0 {
+        return nil
+    }
+
+    if err := json.Unmarshal(body, v); err != nil {
+        log.Printf("Error while decoding the response %s %s", err.Error(), body)
+        return err
+    }
+
+    return nil
+}
+
+/*
+This method returns the URL + Token to be used by each HTTP request.
+If Token needs to be refreshed, then this method will send a POST to ML API to refresh it.
+*/
+func (client *Client) getAuthorizedURL(resourcePath string, params url.Values) (string, error) {
+
+    finalUrl, err := url.Parse(client.ApiUrl + resourcePath)
+
+    if err != nil {
+        return "", err
+    }
+
+    query := finalUrl.Query()
+
+    for key, values := range params {
+        for _, value := range values {
+            query.Add(key, value)
+        }
+    }
+
+    token, err := client.accessToken()
+
+    if err != nil {
+        log.Printf("Error while refreshing token %s\n", err.Error())
+        return "", err
+    }
+
+    if token != "" {
+        query.Set("access_token", token)
+    }
+
+    finalUrl.RawQuery = query.Encode()
+    return finalUrl.String(), nil
+}
+
+/*
+Returns a valid access token, refreshing it when it has expired. Anonymous clients get an empty token.
+Auth is read under authMutex since it may be refreshed by other goroutines using the same client.
+*/
+func (client *Client) accessToken() (string, error) {
+
+    authMutex.Lock()
+    defer authMutex.Unlock()
+
+    if client.Auth == ANONYMOUS {
+        return "", nil
+    }
+
+    if client.Auth.isExpired() {
+        log.Printf("token has expired....refreshing...\n")
+
+        if err := refreshTok(client); err != nil {
+            return "", err
+        }
+    }
+
+    return client.Auth.AccessToken, nil
+}
+
+func (client *Client) httpClient() *http.Client {
+    if client.HttpClient != nil {
+        return client.HttpClient
+    }
+    return http.DefaultClient
+}
+
+func jsonBody(body *string) io.Reader {
+    if body == nil {
+        return nil
+    }
+    return strings.NewReader(*body)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "net/http/httptest"
+    "net/url"
+    "io/ioutil"
+    "time"
+    "sync"
+)
+
+func newTestServerClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+
+    server := httptest.NewServer(handler)
+    client := &Client{ApiUrl:server.URL, Auth:Authorization{AccessToken:"valid token", ExpiresIn:10800, ReceivedAt:time.Now().Unix()}}
+
+    return client, server
+}
+
+func Test_request_builder_merges_query_params_with_the_ones_in_the_path(t *testing.T) {
+
+    var received url.Values
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        received = r.URL.Query()
+    })
+    defer server.Close()
+
+    params := url.Values{}
+    params.Add("status", "active")
+
+    _, err := client.NewRequest(http.MethodGet, "/users/123/items/search?offset=50").Params(params).Query("limit", "10").Do()
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    if received.Get("offset") != "50" || received.Get("status") != "active" || received.Get("limit") != "10" || received.Get("access_token") != "valid token" {
+        log.Printf("unexpected query params %v\n", received)
+        t.FailNow()
+    }
+}
+
+func Test_request_builder_sends_headers_and_json_body(t *testing.T) {
+
+    var contentType, custom, body string
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        contentType = r.Header.Get("Content-Type")
+        custom = r.Header.Get("X-Custom")
+        content, _ := ioutil.ReadAll(r.Body)
+        body = string(content)
+    })
+    defer server.Close()
+
+    payload := struct {
+        Foo string `json:"foo"`
+    }{Foo:"bar"}
+
+    _, err := client.NewRequest(http.MethodPatch, "/items/123").Header("X-Custom", "yes").JSON(payload).Do()
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    if contentType != "application/json" || custom != "yes" || body != "{\"foo\":\"bar\"}" {
+        log.Printf("unexpected request content-type:%s custom:%s body:%s\n", contentType, custom, body)
+        t.FailNow()
+    }
+}
+
+func Test_HEAD_and_OPTIONS_use_the_proper_method(t *testing.T) {
+
+    var methods []string
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        methods = append(methods, r.Method)
+    })
+    defer server.Close()
+
+    client.Head("/items/123")
+    client.Options("/items/123")
+
+    if len(methods) != 2 || methods[0] != http.MethodHead || methods[1] != http.MethodOptions {
+        log.Printf("unexpected methods %v\n", methods)
+        t.FailNow()
+    }
+}
+
+func Test_Decode_returns_an_ApiError_when_status_code_is_not_ok(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(http.StatusForbidden)
+        w.Write([]byte("{\"message\":\"forbidden\",\"error\":\"forbidden\",\"status\":403,\"cause\":[]}"))
+    })
+    defer server.Close()
+
+    err := client.NewRequest(http.MethodGet, "/users/me").Decode(nil)
+
+    apiErr, ok := err.(*ApiError)
+
+    if !ok || apiErr.StatusCode != http.StatusForbidden || apiErr.Message != "forbidden" {
+        log.Printf("unexpected error %v\n", err)
+        t.FailNow()
+    }
+}
+
+func Test_AuthorizationURL_does_not_add_a_separator_after_a_trailing_one(t *testing.T) {
+
+    auth := newAuthorizationURL(API_URL + "/authorizationauth?")
+    auth.addGrantType(AUTHORIZATION_CODE)
+
+    expected := API_URL + "/authorizationauth?grant_type=" + AUTHORIZATION_CODE
+
+    if auth.string() != expected {
+        log.Printf("url was different from what was expected\n expected: %s \n obtained: %s \n", expected, auth.string())
+        t.FailNow()
+    }
+}
+
+func Test_requests_and_token_refreshes_may_run_concurrently_on_the_same_client(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path == "/oauth/token" {
+            w.Write([]byte("{\"access_token\":\"refreshed token\",\"refresh_token\":\"refresh\",\"expires_in\":10800}"))
+        }
+    })
+    defer server.Close()
+
+    var wait sync.WaitGroup
+
+    for i := 0; i < 10; i++ {
+        wait.Add(2)
+        go func() {
+            defer wait.Done()
+            client.NewRequest(http.MethodGet, "/users/me").Do()
+        }()
+        go func() {
+            defer wait.Done()
+            client.RefreshToken()
+        }()
+    }
+
+    wait.Wait()
+}
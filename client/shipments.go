@@ -0,0 +1,200 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+    "strconv"
+    "strings"
+)
+
+const (
+    //Logistic modes
+    MODE_ME1    = "me1"
+    MODE_ME2    = "me2"
+    MODE_CUSTOM = "custom"
+
+    //Logistic types of ME2
+    LOGISTIC_FULFILLMENT   = "fulfillment"
+    LOGISTIC_CROSS_DOCKING = "cross_docking"
+    LOGISTIC_DROP_OFF      = "drop_off"
+    LOGISTIC_XD_DROP_OFF   = "xd_drop_off"
+    LOGISTIC_SELF_SERVICE  = "self_service"
+
+    SHIPMENT_PENDING       = "pending"
+    SHIPMENT_HANDLING      = "handling"
+    SHIPMENT_READY_TO_SHIP = "ready_to_ship"
+    SHIPMENT_SHIPPED       = "shipped"
+    SHIPMENT_DELIVERED     = "delivered"
+    SHIPMENT_NOT_DELIVERED = "not_delivered"
+    SHIPMENT_CANCELLED     = "cancelled"
+
+    LABEL_PDF = "pdf"
+    LABEL_ZPL = "zpl2"
+
+    //Max amount of shipments whose labels may be downloaded at once.
+    MAX_LABELS_PER_REQUEST = 50
+)
+
+type Shipment struct {
+    Id              int64             `json:"id"`
+    OrderId         int64             `json:"order_id"`
+    Mode            string            `json:"mode"`
+    LogisticType    string            `json:"logistic_type"`
+    Status          string            `json:"status"`
+    Substatus       string            `json:"substatus"`
+    TrackingNumber  string            `json:"tracking_number"`
+    TrackingMethod  string            `json:"tracking_method"`
+    SenderId        int64             `json:"sender_id"`
+    ReceiverId      int64             `json:"receiver_id"`
+    DateCreated     string            `json:"date_created"`
+    LastUpdated     string            `json:"last_updated"`
+    ReceiverAddress ShipmentAddress   `json:"receiver_address"`
+    ShippingOption  ShippingOption    `json:"shipping_option"`
+    StatusHistory   map[string]string `json:"status_history"`
+}
+
+type ShipmentAddress struct {
+    AddressLine  string   `json:"address_line"`
+    StreetName   string   `json:"street_name"`
+    StreetNumber string   `json:"street_number"`
+    ZipCode      string   `json:"zip_code"`
+    Comment      string   `json:"comment"`
+    ReceiverName string   `json:"receiver_name"`
+    City         NamedRef `json:"city"`
+    State        NamedRef `json:"state"`
+    Country      NamedRef `json:"country"`
+}
+
+type ShippingOption struct {
+    Id                    int64  `json:"id"`
+    Name                  string `json:"name"`
+    ShippingMethodId      int64  `json:"shipping_method_id"`
+    Cost                  Money  `json:"cost"`
+    ListCost              Money  `json:"list_cost"`
+    CurrencyId            string `json:"currency_id"`
+    EstimatedDeliveryTime struct {
+        Date string `json:"date"`
+    } `json:"estimated_delivery_time"`
+}
+
+/*
+ShipmentEvent is one of the status changes of a shipment.
+*/
+type ShipmentEvent struct {
+    Status    string `json:"status"`
+    Substatus string `json:"substatus"`
+    Date      string `json:"date"`
+}
+
+//Fulfillment shipments are handled by ML from its own warehouses.
+func (s *Shipment) IsFulfillment() bool {
+    return s.LogisticType == LOGISTIC_FULFILLMENT
+}
+
+/*
+Tells whether the seller may print the label: only ME2 shipments which are ready to ship and are not
+handled by ML (fulfillment).
+*/
+func (s *Shipment) CanPrintLabel() bool {
+    return s.Mode == MODE_ME2 && s.Status == SHIPMENT_READY_TO_SHIP && !s.IsFulfillment()
+}
+
+/*
+Shipments gives typed access to the shipments API.
+*/
+type Shipments struct {
+    client *Client
+}
+
+func (client *Client) Shipments() *Shipments {
+    return &Shipments{client: client}
+}
+
+func shipmentPath(shipmentId int64) string {
+    return "/shipments/" + strconv.FormatInt(shipmentId, 10)
+}
+
+func (s *Shipments) Get(shipmentId int64) (*Shipment, error) {
+
+    shipment := new(Shipment)
+
+    if err := s.client.NewRequest(http.MethodGet, shipmentPath(shipmentId)).Header("X-Format-New", "true").Decode(shipment); err != nil {
+        return nil, err
+    }
+
+    return shipment, nil
+}
+
+//Returns the status changes of the shipment, from the oldest to the newest one.
+func (s *Shipments) History(shipmentId int64) ([]ShipmentEvent, error) {
+
+    var history []ShipmentEvent
+    err := s.client.NewRequest(http.MethodGet, shipmentPath(shipmentId)+"/history").Decode(&history)
+
+    return history, err
+}
+
+/*
+This method writes the labels of the given shipments to w, in a single PDF or ZPL document (format LABEL_PDF or LABEL_ZPL).
+At most MAX_LABELS_PER_REQUEST shipments are accepted.
+*/
+func (s *Shipments) Labels(w io.Writer, format string, shipmentIds ...int64) error {
+
+    if format != LABEL_PDF && format != LABEL_ZPL {
+        return fmt.Errorf("Unknown label format %s.", format)
+    }
+
+    if len(shipmentIds) == 0 {
+        return errors.New("At least one shipment is needed for printing labels.")
+    }
+
+    if len(shipmentIds) > MAX_LABELS_PER_REQUEST {
+        return fmt.Errorf("At most %d labels may be printed at once.", MAX_LABELS_PER_REQUEST)
+    }
+
+    ids := make([]string, 0, len(shipmentIds))
+    for _, id := range shipmentIds {
+        ids = append(ids, strconv.FormatInt(id, 10))
+    }
+
+    request := s.client.NewRequest(http.MethodGet, "/shipment_labels").
+        Query("shipment_ids", strings.Join(ids, ",")).
+        Query("response_type", format)
+
+    if format == LABEL_PDF {
+        request.Query("savePdf", "Y")
+    }
+
+    resp, err := request.Do()
+
+    if err != nil {
+        return err
+    }
+
+    if resp.StatusCode >= http.StatusBadRequest {
+        return decodeResponse(resp, nil)
+    }
+
+    defer resp.Body.Close()
+
+    _, err = io.Copy(w, resp.Body)
+    return err
+}
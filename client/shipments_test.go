@@ -0,0 +1,78 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "bytes"
+)
+
+func Test_shipment_is_typed_and_tells_whether_its_label_may_be_printed(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/shipments/42":
+            w.Write([]byte("{\"id\":42,\"mode\":\"me2\",\"logistic_type\":\"drop_off\",\"status\":\"ready_to_ship\",\"substatus\":\"ready_to_print\"}"))
+        case "/shipments/42/history":
+            w.Write([]byte("[{\"status\":\"handling\",\"date\":\"2016-06-20T10:00:00.000-04:00\"},{\"status\":\"ready_to_ship\",\"substatus\":\"ready_to_print\"}]"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    shipment, err := client.Shipments().Get(42)
+
+    if err != nil || !shipment.CanPrintLabel() || shipment.Substatus != "ready_to_print" {
+        log.Printf("unexpected shipment %v %v\n", shipment, err)
+        t.FailNow()
+    }
+
+    history, err := client.Shipments().History(42)
+
+    if err != nil || len(history) != 2 || history[1].Status != SHIPMENT_READY_TO_SHIP {
+        log.Printf("unexpected history %v %v\n", history, err)
+        t.FailNow()
+    }
+}
+
+func Test_labels_of_several_shipments_are_written_to_the_writer(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        query := r.URL.Query()
+        if r.URL.Path != "/shipment_labels" || query.Get("shipment_ids") != "1,2" || query.Get("response_type") != "zpl2" {
+            w.WriteHeader(http.StatusBadRequest)
+            return
+        }
+        w.Write([]byte("^XA^FDlabel^FS^XZ"))
+    })
+    defer server.Close()
+
+    var out bytes.Buffer
+
+    if err := client.Shipments().Labels(&out, LABEL_ZPL, 1, 2); err != nil || out.String() != "^XA^FDlabel^FS^XZ" {
+        log.Printf("unexpected label %s %v\n", out.String(), err)
+        t.FailNow()
+    }
+
+    if err := client.Shipments().Labels(&out, "png", 1); err == nil {
+        log.Printf("unknown formats should fail\n")
+        t.FailNow()
+    }
+}
@@ -0,0 +1,96 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import "sort"
+
+/*
+Site keeps what the SDK needs to know about each ML site. AuthURL is the one to be passed to GetAuthURL.
+The first currency is the local one.
+*/
+type Site struct {
+    Id         string
+    Name       string
+    AuthURL    string
+    Currencies []string
+}
+
+//Registry of the sites, by id.
+var Sites = map[string]Site{
+    "MLA": {Id: "MLA", Name: "Argentina", AuthURL: MLA, Currencies: []string{"ARS", "USD"}},
+    "MLB": {Id: "MLB", Name: "Brasil", AuthURL: MLB, Currencies: []string{"BRL"}},
+    "MCO": {Id: "MCO", Name: "Colombia", AuthURL: MCO, Currencies: []string{"COP"}},
+    "MCR": {Id: "MCR", Name: "Costa Rica", AuthURL: MCR, Currencies: []string{"CRC", "USD"}},
+    "MEC": {Id: "MEC", Name: "Ecuador", AuthURL: MEC, Currencies: []string{"USD"}},
+    "MLC": {Id: "MLC", Name: "Chile", AuthURL: MLC, Currencies: []string{"CLP"}},
+    "MLM": {Id: "MLM", Name: "Mexico", AuthURL: MLM, Currencies: []string{"MXN"}},
+    "MLU": {Id: "MLU", Name: "Uruguay", AuthURL: MLU, Currencies: []string{"UYU", "USD"}},
+    "MLV": {Id: "MLV", Name: "Venezuela", AuthURL: MLV, Currencies: []string{"VES", "USD"}},
+    "MPA": {Id: "MPA", Name: "Panamá", AuthURL: MPA, Currencies: []string{"PAB", "USD"}},
+    "MPE": {Id: "MPE", Name: "Perú", AuthURL: MPE, Currencies: []string{"PEN", "USD"}},
+    "MPT": {Id: "MPT", Name: "Portugal", AuthURL: MPT, Currencies: []string{"EUR"}},
+    "MRD": {Id: "MRD", Name: "Dominicana", AuthURL: MRD, Currencies: []string{"DOP", "USD"}},
+}
+
+func GetSite(siteId string) (Site, bool) {
+    site, ok := Sites[siteId]
+    return site, ok
+}
+
+//Returns the ids of every site, sorted.
+func SiteIds() []string {
+
+    ids := make([]string, 0, len(Sites))
+
+    for id := range Sites {
+        ids = append(ids, id)
+    }
+
+    sort.Strings(ids)
+    return ids
+}
+
+func (site Site) LocalCurrency() string {
+    return site.Currencies[0]
+}
+
+func (site Site) AcceptsCurrency(currencyId string) bool {
+
+    for _, currency := range site.Currencies {
+        if currency == currencyId {
+            return true
+        }
+    }
+
+    return false
+}
+
+/*
+Item, category and user ids start with the id of their site, for instance MLA1912.
+*/
+func siteOf(id string) string {
+
+    if len(id) < 3 {
+        return ""
+    }
+
+    if _, ok := Sites[id[:3]]; !ok {
+        return ""
+    }
+
+    return id[:3]
+}
@@ -0,0 +1,276 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "fmt"
+    "net/http"
+    "net/url"
+    "strconv"
+    "time"
+)
+
+const (
+    //Types of the locations of the stock
+    STOCK_MELI_FACILITY    = "meli_facility"
+    STOCK_SELLING_ADDRESS  = "selling_address"
+    STOCK_SELLER_WAREHOUSE = "seller_warehouse"
+
+    //Layout of the dates used by the stock operations API.
+    STOCK_DATE_LAYOUT = "2006-01-02"
+)
+
+/*
+FulfillmentStock is the stock of an inventory in the warehouses of ML. Only AvailableQuantity may be sold;
+NotAvailableDetail tells why the rest may not, for instance damaged or in transfer.
+*/
+type FulfillmentStock struct {
+    InventoryId          string `json:"inventory_id"`
+    Total                int    `json:"total"`
+    AvailableQuantity    int    `json:"available_quantity"`
+    NotAvailableQuantity int    `json:"not_available_quantity"`
+    NotAvailableDetail   []struct {
+        Status   string `json:"status"`
+        Quantity int    `json:"quantity"`
+    } `json:"not_available_detail"`
+    ExternalReferences []StockReference `json:"external_references"`
+}
+
+type StockReference struct {
+    Type        string `json:"type"`
+    Id          string `json:"id"`
+    VariationId int64  `json:"variation_id"`
+}
+
+/*
+StockOperation is a movement of the stock of an inventory, such as an inbound shipment or a sale.
+Detail is the change and Result the stock after it.
+*/
+type StockOperation struct {
+    Id          int64  `json:"id"`
+    SellerId    int64  `json:"seller_id"`
+    InventoryId string `json:"inventory_id"`
+    Type        string `json:"type"`
+    DateCreated string `json:"date_created"`
+    Detail      struct {
+        AvailableQuantity    int `json:"available_quantity"`
+        NotAvailableQuantity int `json:"not_available_quantity"`
+    } `json:"detail"`
+    Result struct {
+        Total                int `json:"total"`
+        AvailableQuantity    int `json:"available_quantity"`
+        NotAvailableQuantity int `json:"not_available_quantity"`
+    } `json:"result"`
+}
+
+//Page decoded by the Pagers of Stock.Operations.
+type StockOperations struct {
+    Paging  Paging           `json:"paging"`
+    Results []StockOperation `json:"results"`
+}
+
+type StockLocation struct {
+    Type     string `json:"type"`
+    Quantity int    `json:"quantity"`
+}
+
+/*
+StockMismatch is an item, or a variation when VariationId is not 0, whose available_quantity differs from the
+available stock of its inventory.
+*/
+type StockMismatch struct {
+    ItemId            string
+    VariationId       int64
+    InventoryId       string
+    ItemQuantity      int
+    InventoryQuantity int
+}
+
+/*
+Stock gives typed access to the stock of the items, in fulfillment and in the other locations of the seller.
+*/
+type Stock struct {
+    client *Client
+}
+
+func (client *Client) Stock() *Stock {
+    return &Stock{client: client}
+}
+
+func (s *Stock) Fulfillment(inventoryId string) (*FulfillmentStock, error) {
+
+    stock := new(FulfillmentStock)
+
+    if err := s.client.NewRequest(http.MethodGet, "/inventories/"+inventoryId+"/stock/fulfillment").Decode(stock); err != nil {
+        return nil, err
+    }
+
+    return stock, nil
+}
+
+/*
+This method returns a Pager over the operations of the inventory between from and to. Each page is decoded into
+StockOperations.
+*/
+func (s *Stock) Operations(sellerId int64, inventoryId string, from time.Time, to time.Time, limit int) *Pager {
+
+    params := url.Values{}
+    params.Set("seller_id", strconv.FormatInt(sellerId, 10))
+    params.Set("inventory_id", inventoryId)
+    params.Set("date_from", from.Format(STOCK_DATE_LAYOUT))
+    params.Set("date_to", to.Format(STOCK_DATE_LAYOUT))
+
+    return s.client.NewPager("/stock/fulfillment/operations/search", params, limit)
+}
+
+//Returns the stock of the user product in each of its locations.
+func (s *Stock) Locations(userProductId string) ([]StockLocation, error) {
+
+    var stock struct {
+        Locations []StockLocation `json:"locations"`
+    }
+
+    err := s.client.NewRequest(http.MethodGet, "/user-products/"+userProductId+"/stock").Decode(&stock)
+
+    return stock.Locations, err
+}
+
+/*
+This method compares the available_quantity of the items and their variations against the fulfillment stock of
+their inventories. Items and variations without inventory are not in fulfillment and are skipped.
+*/
+func (s *Stock) Reconcile(items []Item) ([]StockMismatch, error) {
+
+    var mismatches []StockMismatch
+
+    check := func(itemId string, variationId int64, inventoryId string, quantity int) error {
+
+        stock, err := s.Fulfillment(inventoryId)
+
+        if err != nil {
+            return err
+        }
+
+        if stock.AvailableQuantity != quantity {
+            mismatches = append(mismatches, StockMismatch{ItemId: itemId, VariationId: variationId, InventoryId: inventoryId,
+                ItemQuantity: quantity, InventoryQuantity: stock.AvailableQuantity})
+        }
+
+        return nil
+    }
+
+    for _, item := range items {
+
+        if len(item.Variations) == 0 {
+            if item.InventoryId != "" {
+                if err := check(item.Id, 0, item.InventoryId, item.AvailableQuantity); err != nil {
+                    return nil, err
+                }
+            }
+            continue
+        }
+
+        for _, variation := range item.Variations {
+            if variation.InventoryId != "" {
+                if err := check(item.Id, variation.Id, variation.InventoryId, variation.AvailableQuantity); err != nil {
+                    return nil, err
+                }
+            }
+        }
+    }
+
+    return mismatches, nil
+}
+
+/*
+This function turns the mismatches into the updates which set the quantities of the inventories, one per item,
+ready to be sent to BulkUpdateItems. The items are the ones given to Reconcile: a PUT of the variations deletes the
+variations left out of the body, so every variation of the item is sent and only the mismatched quantities change.
+
+    updates, err := sdk.StockUpdates(items, mismatches)
+    results, err := client.BulkUpdateItems(updates, options)
+*/
+func StockUpdates(items []Item, mismatches []StockMismatch) (<-chan ItemUpdate, error) {
+
+    byId := map[string]*Item{}
+    for i := range items {
+        byId[items[i].Id] = &items[i]
+    }
+
+    var order []string
+    seen := map[string]bool{}
+    quantities := map[string]int{}
+    variations := map[string]map[int64]int{}
+
+    for _, mismatch := range mismatches {
+
+        item, ok := byId[mismatch.ItemId]
+
+        if !ok {
+            return nil, fmt.Errorf("The item %s of the mismatch is not among the given items.", mismatch.ItemId)
+        }
+
+        if !seen[item.Id] {
+            seen[item.Id] = true
+            order = append(order, item.Id)
+        }
+
+        if mismatch.VariationId == 0 {
+            quantities[item.Id] = mismatch.InventoryQuantity
+            continue
+        }
+
+        if variations[item.Id] == nil {
+            variations[item.Id] = map[int64]int{}
+        }
+        variations[item.Id][mismatch.VariationId] = mismatch.InventoryQuantity
+    }
+
+    updates := make(chan ItemUpdate, len(order))
+
+    for _, itemId := range order {
+
+        changes, ok := variations[itemId]
+
+        if !ok {
+            updates <- ItemUpdate{ItemId: itemId, Body: map[string]interface{}{"available_quantity": quantities[itemId]}}
+            continue
+        }
+
+        var body []map[string]interface{}
+
+        for _, variation := range byId[itemId].Variations {
+
+            quantity, changed := changes[variation.Id]
+            if !changed {
+                quantity = variation.AvailableQuantity
+            }
+            delete(changes, variation.Id)
+
+            body = append(body, map[string]interface{}{"id": variation.Id, "available_quantity": quantity})
+        }
+
+        for variationId := range changes {
+            return nil, fmt.Errorf("The variation %d of the mismatch is not among the variations of item %s.", variationId, itemId)
+        }
+
+        updates <- ItemUpdate{ItemId: itemId, Body: map[string]interface{}{"variations": body}}
+    }
+
+    close(updates)
+    return updates, nil
+}
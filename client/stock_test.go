@@ -0,0 +1,155 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "encoding/json"
+    "strings"
+    "time"
+)
+
+func Test_items_are_reconciled_against_their_fulfillment_stock(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/inventories/INV1/stock/fulfillment":
+            w.Write([]byte("{\"inventory_id\":\"INV1\",\"total\":12,\"available_quantity\":10,\"not_available_quantity\":2}"))
+        case "/inventories/INV2/stock/fulfillment":
+            w.Write([]byte("{\"inventory_id\":\"INV2\",\"available_quantity\":3}"))
+        case "/inventories/INV3/stock/fulfillment":
+            w.Write([]byte("{\"inventory_id\":\"INV3\",\"available_quantity\":0}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    items := []Item{
+        {Id: "MLA1", InventoryId: "INV1", AvailableQuantity: 7},
+        {Id: "MLA2", AvailableQuantity: 100},
+        {Id: "MLA3", Variations: []Variation{
+            {Id: 31, InventoryId: "INV2", AvailableQuantity: 3},
+            {Id: 32, InventoryId: "INV3", AvailableQuantity: 4},
+        }},
+    }
+
+    mismatches, err := client.Stock().Reconcile(items)
+
+    if err != nil || len(mismatches) != 2 {
+        log.Printf("unexpected mismatches %v %v\n", mismatches, err)
+        t.FailNow()
+    }
+
+    if mismatches[0].ItemId != "MLA1" || mismatches[0].InventoryQuantity != 10 || mismatches[1].VariationId != 32 || mismatches[1].InventoryQuantity != 0 {
+        log.Printf("unexpected mismatches %v\n", mismatches)
+        t.FailNow()
+    }
+
+    updates, err := StockUpdates(items, mismatches)
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    var bodies []string
+    for update := range updates {
+        body, _ := json.Marshal(update.Body)
+        bodies = append(bodies, update.ItemId+" "+string(body))
+    }
+
+    //The variation without mismatch is sent with its quantity, since the PUT deletes the ones left out.
+    expected := "MLA1 {\"available_quantity\":10}|MLA3 {\"variations\":[{\"available_quantity\":3,\"id\":31},{\"available_quantity\":0,\"id\":32}]}"
+
+    if strings.Join(bodies, "|") != expected {
+        log.Printf("unexpected updates %v\n", bodies)
+        t.FailNow()
+    }
+}
+
+func Test_stock_updates_keep_the_variations_without_mismatches(t *testing.T) {
+
+    items := []Item{
+        {Id: "MLA3", Variations: []Variation{
+            {Id: 31, AvailableQuantity: 3},
+            {Id: 32, InventoryId: "INV3", AvailableQuantity: 4},
+            {Id: 33, AvailableQuantity: 8},
+        }},
+    }
+
+    updates, err := StockUpdates(items, []StockMismatch{{ItemId: "MLA3", VariationId: 32, InventoryId: "INV3", ItemQuantity: 4}})
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    body, _ := json.Marshal((<-updates).Body)
+    expected := "{\"variations\":[{\"available_quantity\":3,\"id\":31},{\"available_quantity\":0,\"id\":32},{\"available_quantity\":8,\"id\":33}]}"
+
+    if string(body) != expected {
+        log.Printf("every variation of the item should be sent %s\n", body)
+        t.FailNow()
+    }
+
+    if _, err := StockUpdates(nil, []StockMismatch{{ItemId: "MLA3", VariationId: 32}}); err == nil {
+        log.Printf("mismatches of unknown items should fail\n")
+        t.FailNow()
+    }
+
+    if _, err := StockUpdates(items, []StockMismatch{{ItemId: "MLA3", VariationId: 34}}); err == nil {
+        log.Printf("mismatches of unknown variations should fail\n")
+        t.FailNow()
+    }
+}
+
+func Test_stock_operations_and_locations_are_typed(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/stock/fulfillment/operations/search":
+            if r.URL.Query().Get("inventory_id") != "INV1" || r.URL.Query().Get("seller_id") != "2" {
+                w.WriteHeader(http.StatusBadRequest)
+                return
+            }
+            w.Write([]byte("{\"paging\":{\"total\":1},\"results\":[{\"id\":1,\"type\":\"inbound_reception\",\"detail\":{\"available_quantity\":5},\"result\":{\"available_quantity\":10}}]}"))
+        case "/user-products/MLAU1/stock":
+            w.Write([]byte("{\"locations\":[{\"type\":\"meli_facility\",\"quantity\":10},{\"type\":\"selling_address\",\"quantity\":2}]}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    var page StockOperations
+    from := time.Now().AddDate(0, 0, -7)
+
+    if err := client.Stock().Operations(2, "INV1", from, time.Now(), 0).Next(&page); err != nil || len(page.Results) != 1 || page.Results[0].Result.AvailableQuantity != 10 {
+        log.Printf("unexpected operations %v %v\n", page, err)
+        t.FailNow()
+    }
+
+    locations, err := client.Stock().Locations("MLAU1")
+
+    if err != nil || len(locations) != 2 || locations[0].Type != STOCK_MELI_FACILITY {
+        log.Printf("unexpected locations %v %v\n", locations, err)
+        t.FailNow()
+    }
+}
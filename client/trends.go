@@ -0,0 +1,277 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+    "sort"
+    "time"
+)
+
+const (
+    //Kinds of TrendChange
+    TREND_APPEARED = "appeared"
+    TREND_DROPPED  = "dropped"
+    TREND_MOVED    = "moved"
+)
+
+type Trend struct {
+    Keyword string `json:"keyword"`
+    Url     string `json:"url"`
+}
+
+/*
+Highlights is the best sellers ranking of a category. Content is sorted by Position, starting at 1.
+*/
+type Highlights struct {
+    QueryData struct {
+        HighlightType string `json:"highlight_type"`
+        Criteria      string `json:"criteria"`
+        Id            string `json:"id"`
+    } `json:"query_data"`
+    Content []HighlightEntry `json:"content"`
+}
+
+type HighlightEntry struct {
+    Id       string `json:"id"`
+    Position int    `json:"position"`
+    Type     string `json:"type"`
+}
+
+/*
+Trends gives typed access to the trends of the sites and the best sellers of the categories.
+*/
+type Trends struct {
+    client *Client
+}
+
+func (client *Client) Trends() *Trends {
+    return &Trends{client: client}
+}
+
+//Returns the most searched keywords of the site, from the most to the least searched one.
+func (t *Trends) ForSite(siteId string) ([]Trend, error) {
+
+    var trends []Trend
+    err := t.client.NewRequest(http.MethodGet, "/trends/"+siteId).Decode(&trends)
+
+    return trends, err
+}
+
+//Returns the most searched keywords of the category.
+func (t *Trends) ForCategory(categoryId string) ([]Trend, error) {
+
+    siteId := siteOf(categoryId)
+
+    if siteId == "" {
+        return nil, errors.New("Unknown site for category " + categoryId)
+    }
+
+    var trends []Trend
+    err := t.client.NewRequest(http.MethodGet, "/trends/"+siteId+"/"+categoryId).Decode(&trends)
+
+    return trends, err
+}
+
+//Returns the best sellers ranking of the category.
+func (t *Trends) BestSellers(categoryId string) (*Highlights, error) {
+
+    siteId := siteOf(categoryId)
+
+    if siteId == "" {
+        return nil, errors.New("Unknown site for category " + categoryId)
+    }
+
+    highlights := new(Highlights)
+
+    if err := t.client.NewRequest(http.MethodGet, "/highlights/"+siteId+"/category/"+categoryId).Decode(highlights); err != nil {
+        return nil, err
+    }
+
+    sort.SliceStable(highlights.Content, func(i, j int) bool {
+        return highlights.Content[i].Position < highlights.Content[j].Position
+    })
+
+    return highlights, nil
+}
+
+/*
+TrendsSnapshot is the state of the trends and best sellers at a point in time. Rankings are keyed by site id
+for the trends of a site and by category id for the best sellers of a category; each one lists the keywords
+or ids from the first to the last position. It may be saved between runs and compared with DiffTrends.
+*/
+type TrendsSnapshot struct {
+    TakenAt     time.Time           `json:"taken_at"`
+    Trends      map[string][]string `json:"trends"`
+    BestSellers map[string][]string `json:"best_sellers"`
+}
+
+/*
+This method takes a snapshot of the trends of the given sites and the best sellers of the given categories.
+When siteIds is empty, every site in Sites is used.
+*/
+func (t *Trends) Snapshot(siteIds []string, categoryIds []string) (*TrendsSnapshot, error) {
+
+    if len(siteIds) == 0 {
+        siteIds = SiteIds()
+    }
+
+    snapshot := &TrendsSnapshot{TakenAt: time.Now(), Trends: map[string][]string{}, BestSellers: map[string][]string{}}
+
+    for _, siteId := range siteIds {
+
+        if _, ok := GetSite(siteId); !ok {
+            return nil, errors.New("Unknown site " + siteId)
+        }
+
+        trends, err := t.ForSite(siteId)
+
+        if err != nil {
+            return nil, err
+        }
+
+        keywords := make([]string, 0, len(trends))
+        for _, trend := range trends {
+            keywords = append(keywords, trend.Keyword)
+        }
+        snapshot.Trends[siteId] = keywords
+    }
+
+    for _, categoryId := range categoryIds {
+
+        highlights, err := t.BestSellers(categoryId)
+
+        if err != nil {
+            return nil, err
+        }
+
+        ids := make([]string, 0, len(highlights.Content))
+        for _, entry := range highlights.Content {
+            ids = append(ids, entry.Id)
+        }
+        snapshot.BestSellers[categoryId] = ids
+    }
+
+    return snapshot, nil
+}
+
+func (s *TrendsSnapshot) Save(w io.Writer) error {
+    return json.NewEncoder(w).Encode(s)
+}
+
+func LoadTrendsSnapshot(r io.Reader) (*TrendsSnapshot, error) {
+
+    snapshot := new(TrendsSnapshot)
+
+    if err := json.NewDecoder(r).Decode(snapshot); err != nil {
+        return nil, err
+    }
+
+    return snapshot, nil
+}
+
+/*
+TrendChange is an entry whose position changed between two snapshots. Ranking is the site or category id,
+and From and To are the positions starting at 1, or 0 when the entry was not in the ranking.
+*/
+type TrendChange struct {
+    Ranking string
+    Entry   string
+    Kind    string
+    From    int
+    To      int
+}
+
+func (c TrendChange) String() string {
+
+    switch c.Kind {
+    case TREND_APPEARED:
+        return fmt.Sprintf("%s: %s appeared at %d", c.Ranking, c.Entry, c.To)
+    case TREND_DROPPED:
+        return fmt.Sprintf("%s: %s dropped from %d", c.Ranking, c.Entry, c.From)
+    }
+
+    return fmt.Sprintf("%s: %s moved from %d to %d", c.Ranking, c.Entry, c.From, c.To)
+}
+
+/*
+This function compares two snapshots and returns the entries which moved, appeared or dropped, sorted by ranking.
+Rankings present in only one of the snapshots are skipped, since they were not taken in both runs.
+*/
+func DiffTrends(previous *TrendsSnapshot, current *TrendsSnapshot) []TrendChange {
+
+    var changes []TrendChange
+
+    changes = append(changes, diffRankings(previous.Trends, current.Trends)...)
+    changes = append(changes, diffRankings(previous.BestSellers, current.BestSellers)...)
+
+    return changes
+}
+
+func diffRankings(previous map[string][]string, current map[string][]string) []TrendChange {
+
+    keys := make([]string, 0, len(current))
+    for key := range current {
+        if _, ok := previous[key]; ok {
+            keys = append(keys, key)
+        }
+    }
+    sort.Strings(keys)
+
+    var changes []TrendChange
+
+    for _, key := range keys {
+
+        before := positions(previous[key])
+        after := positions(current[key])
+
+        for i, entry := range current[key] {
+            from, ok := before[entry]
+            switch {
+            case !ok:
+                changes = append(changes, TrendChange{Ranking: key, Entry: entry, Kind: TREND_APPEARED, To: i + 1})
+            case from != i+1:
+                changes = append(changes, TrendChange{Ranking: key, Entry: entry, Kind: TREND_MOVED, From: from, To: i + 1})
+            }
+        }
+
+        for i, entry := range previous[key] {
+            if _, ok := after[entry]; !ok {
+                changes = append(changes, TrendChange{Ranking: key, Entry: entry, Kind: TREND_DROPPED, From: i + 1})
+            }
+        }
+    }
+
+    return changes
+}
+
+func positions(entries []string) map[string]int {
+
+    result := make(map[string]int, len(entries))
+
+    for i, entry := range entries {
+        if _, ok := result[entry]; !ok {
+            result[entry] = i + 1
+        }
+    }
+
+    return result
+}
@@ -0,0 +1,98 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "bytes"
+    "strings"
+)
+
+func Test_snapshot_takes_trends_of_every_site_in_the_registry(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch {
+        case strings.HasPrefix(r.URL.Path, "/trends/"):
+            w.Write([]byte("[{\"keyword\":\"" + strings.TrimPrefix(r.URL.Path, "/trends/") + " phone\"}]"))
+        case r.URL.Path == "/highlights/MLA/category/MLA1051":
+            w.Write([]byte("{\"content\":[{\"id\":\"MLA2\",\"position\":2},{\"id\":\"MLA1\",\"position\":1}]}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+    defer server.Close()
+
+    snapshot, err := client.Trends().Snapshot(nil, []string{"MLA1051"})
+
+    if err != nil || len(snapshot.Trends) != len(Sites) || snapshot.Trends["MLB"][0] != "MLB phone" {
+        log.Printf("unexpected snapshot %v %v\n", snapshot, err)
+        t.FailNow()
+    }
+
+    if best := snapshot.BestSellers["MLA1051"]; len(best) != 2 || best[0] != "MLA1" {
+        log.Printf("best sellers should be sorted by position %v\n", best)
+        t.FailNow()
+    }
+
+    if _, err := client.Trends().Snapshot([]string{"XXX"}, nil); err == nil {
+        log.Printf("unknown sites should fail\n")
+        t.FailNow()
+    }
+}
+
+func Test_snapshots_are_diffed_between_runs(t *testing.T) {
+
+    previous := &TrendsSnapshot{
+        Trends:      map[string][]string{"MLA": {"phone", "tv", "shoes"}, "MLB": {"bola"}},
+        BestSellers: map[string][]string{"MLA1051": {"MLA1", "MLA2"}},
+    }
+
+    var saved bytes.Buffer
+    if err := previous.Save(&saved); err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    loaded, err := LoadTrendsSnapshot(&saved)
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    current := &TrendsSnapshot{
+        Trends:      map[string][]string{"MLA": {"tv", "phone", "bike"}, "MLM": {"celular"}},
+        BestSellers: map[string][]string{"MLA1051": {"MLA1", "MLA2"}},
+    }
+
+    changes := DiffTrends(loaded, current)
+    expected := []string{"MLA: tv moved from 2 to 1", "MLA: phone moved from 1 to 2", "MLA: bike appeared at 3", "MLA: shoes dropped from 3"}
+
+    if len(changes) != len(expected) {
+        log.Printf("unexpected changes %v\n", changes)
+        t.FailNow()
+    }
+
+    for i, change := range changes {
+        if change.String() != expected[i] {
+            log.Printf("expected %s but got %s\n", expected[i], change)
+            t.FailNow()
+        }
+    }
+}
@@ -0,0 +1,295 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+    "strings"
+)
+
+//Max amount of ids accepted by the multiget of users.
+const MAX_USERS_PER_REQUEST = 20
+
+type User struct {
+    Id               int64            `json:"id"`
+    Nickname         string           `json:"nickname"`
+    RegistrationDate string           `json:"registration_date"`
+    FirstName        string           `json:"first_name"`
+    LastName         string           `json:"last_name"`
+    Email            string           `json:"email"`
+    CountryId        string           `json:"country_id"`
+    SiteId           string           `json:"site_id"`
+    UserType         string           `json:"user_type"`
+    Permalink        string           `json:"permalink"`
+    Points           int              `json:"points"`
+    Tags             []string         `json:"tags"`
+    Identification   Identification   `json:"identification"`
+    Address          UserAddress      `json:"address"`
+    Phone            Phone            `json:"phone"`
+    SellerReputation SellerReputation `json:"seller_reputation"`
+    Status           UserStatus       `json:"status"`
+}
+
+type Identification struct {
+    Type   string `json:"type"`
+    Number string `json:"number"`
+}
+
+type UserAddress struct {
+    State   string `json:"state"`
+    City    string `json:"city"`
+    Address string `json:"address"`
+    ZipCode string `json:"zip_code"`
+}
+
+type Phone struct {
+    AreaCode  string `json:"area_code"`
+    Number    string `json:"number"`
+    Extension string `json:"extension"`
+}
+
+type UserStatus struct {
+    SiteStatus string `json:"site_status"`
+}
+
+/*
+SellerReputation is the reputation of the user as a seller. LevelId looks like 5_green; see Level and Color.
+*/
+type SellerReputation struct {
+    LevelId           string                 `json:"level_id"`
+    PowerSellerStatus string                 `json:"power_seller_status"`
+    Transactions      ReputationTransactions `json:"transactions"`
+    Metrics           ReputationMetrics      `json:"metrics"`
+}
+
+type ReputationTransactions struct {
+    Period    string            `json:"period"`
+    Total     int               `json:"total"`
+    Completed int               `json:"completed"`
+    Canceled  int               `json:"canceled"`
+    Ratings   ReputationRatings `json:"ratings"`
+}
+
+type ReputationRatings struct {
+    Positive float64 `json:"positive"`
+    Neutral  float64 `json:"neutral"`
+    Negative float64 `json:"negative"`
+}
+
+type ReputationMetrics struct {
+    Sales               ReputationMetric `json:"sales"`
+    Claims              ReputationMetric `json:"claims"`
+    DelayedHandlingTime ReputationMetric `json:"delayed_handling_time"`
+    Cancellations       ReputationMetric `json:"cancellations"`
+}
+
+type ReputationMetric struct {
+    Period    string  `json:"period"`
+    Rate      float64 `json:"rate"`
+    Value     int     `json:"value"`
+    Completed int     `json:"completed"`
+}
+
+//Returns the level of the reputation, from 1 (red) to 5 (green). 0 means the seller has no reputation yet.
+func (r SellerReputation) Level() int {
+
+    level, err := strconv.Atoi(strings.SplitN(r.LevelId, "_", 2)[0])
+
+    if err != nil {
+        return 0
+    }
+
+    return level
+}
+
+//Returns the color of the reputation thermometer, for instance light_green.
+func (r SellerReputation) Color() string {
+
+    parts := strings.SplitN(r.LevelId, "_", 2)
+
+    if len(parts) != 2 {
+        return ""
+    }
+
+    return parts[1]
+}
+
+//Tells whether the seller is a power seller (silver, gold or platinum).
+func (r SellerReputation) IsPowerSeller() bool {
+    return r.PowerSellerStatus != ""
+}
+
+type Address struct {
+    Id           int64    `json:"id"`
+    UserId       int64    `json:"user_id"`
+    AddressLine  string   `json:"address_line"`
+    StreetName   string   `json:"street_name"`
+    StreetNumber string   `json:"street_number"`
+    Comment      string   `json:"comment"`
+    ZipCode      string   `json:"zip_code"`
+    City         NamedRef `json:"city"`
+    State        NamedRef `json:"state"`
+    Country      NamedRef `json:"country"`
+    Types        []string `json:"types"`
+    Latitude     float64  `json:"latitude"`
+    Longitude    float64  `json:"longitude"`
+}
+
+type NamedRef struct {
+    Id   string `json:"id"`
+    Name string `json:"name"`
+}
+
+type PaymentMethod struct {
+    Id              string `json:"id"`
+    Name            string `json:"name"`
+    PaymentTypeId   string `json:"payment_type_id"`
+    Thumbnail       string `json:"thumbnail"`
+    SecureThumbnail string `json:"secure_thumbnail"`
+}
+
+/*
+Users gives typed access to the users API.
+*/
+type Users struct {
+    client *Client
+}
+
+func (client *Client) Users() *Users {
+    return &Users{client: client}
+}
+
+func userPath(userId int64) string {
+    return "/users/" + strconv.FormatInt(userId, 10)
+}
+
+//Returns the user the token belongs to.
+func (u *Users) Me() (*User, error) {
+
+    user := new(User)
+
+    if err := u.client.NewRequest(http.MethodGet, "/users/me").Decode(user); err != nil {
+        return nil, err
+    }
+
+    return user, nil
+}
+
+func (u *Users) Get(userId int64) (*User, error) {
+
+    user := new(User)
+
+    if err := u.client.NewRequest(http.MethodGet, userPath(userId)).Decode(user); err != nil {
+        return nil, err
+    }
+
+    return user, nil
+}
+
+/*
+This method gets several users, MAX_USERS_PER_REQUEST per call. Users which could not be found are left out,
+so the result may be shorter than ids.
+*/
+func (u *Users) GetMany(ids []int64) ([]User, error) {
+
+    users := make([]User, 0, len(ids))
+
+    for start := 0; start < len(ids); start += MAX_USERS_PER_REQUEST {
+
+        end := start + MAX_USERS_PER_REQUEST
+        if end > len(ids) {
+            end = len(ids)
+        }
+
+        values := make([]string, 0, end-start)
+        for _, id := range ids[start:end] {
+            values = append(values, strconv.FormatInt(id, 10))
+        }
+
+        var results []struct {
+            Code int  `json:"code"`
+            Body User `json:"body"`
+        }
+
+        if err := u.client.NewRequest(http.MethodGet, "/users").Query("ids", strings.Join(values, ",")).Decode(&results); err != nil {
+            return nil, err
+        }
+
+        for _, result := range results {
+            if result.Code == http.StatusOK {
+                users = append(users, result.Body)
+            }
+        }
+    }
+
+    return users, nil
+}
+
+func (u *Users) Addresses(userId int64) ([]Address, error) {
+
+    var addresses []Address
+    err := u.client.NewRequest(http.MethodGet, userPath(userId)+"/addresses").Decode(&addresses)
+
+    return addresses, err
+}
+
+func (u *Users) AcceptedPaymentMethods(userId int64) ([]PaymentMethod, error) {
+
+    var methods []PaymentMethod
+    err := u.client.NewRequest(http.MethodGet, userPath(userId)+"/accepted_payment_methods").Decode(&methods)
+
+    return methods, err
+}
+
+/*
+This method resolves the nickname of a seller to its id, searching the items it lists in the site.
+*/
+func (u *Users) IdByNickname(siteId string, nickname string) (int64, error) {
+
+    var search struct {
+        Seller struct {
+            Id       int64  `json:"id"`
+            Nickname string `json:"nickname"`
+        } `json:"seller"`
+    }
+
+    err := u.client.NewRequest(http.MethodGet, "/sites/"+siteId+"/search").Query("nickname", nickname).Query("limit", "1").Decode(&search)
+
+    if err != nil {
+        return 0, err
+    }
+
+    if search.Seller.Id == 0 {
+        return 0, errors.New("No user was found with nickname " + nickname)
+    }
+
+    return search.Seller.Id, nil
+}
+
+//Same as IdByNickname, but returns the whole user.
+func (u *Users) GetByNickname(siteId string, nickname string) (*User, error) {
+
+    id, err := u.IdByNickname(siteId, nickname)
+
+    if err != nil {
+        return nil, err
+    }
+
+    return u.Get(id)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "strings"
+    "fmt"
+)
+
+func Test_Me_returns_the_typed_user_with_its_reputation(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte("{\"id\":123456,\"nickname\":\"foobar\",\"seller_reputation\":{\"level_id\":\"4_light_green\",\"power_seller_status\":\"gold\"," +
+            "\"transactions\":{\"total\":10,\"completed\":9,\"ratings\":{\"positive\":0.9}},\"metrics\":{\"claims\":{\"rate\":0.01}}}}"))
+    })
+    defer server.Close()
+
+    user, err := client.Users().Me()
+
+    if err != nil || user.Id != 123456 || user.Nickname != "foobar" {
+        log.Printf("unexpected user %v %v\n", user, err)
+        t.FailNow()
+    }
+
+    reputation := user.SellerReputation
+
+    if reputation.Level() != 4 || reputation.Color() != "light_green" || !reputation.IsPowerSeller() || reputation.Metrics.Claims.Rate != 0.01 {
+        log.Printf("unexpected reputation %v\n", reputation)
+        t.FailNow()
+    }
+}
+
+func Test_GetMany_splits_the_ids_and_leaves_out_the_missing_users(t *testing.T) {
+
+    calls := 0
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        calls++
+        var results []string
+        for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+            if id == "7" {
+                results = append(results, "{\"code\":404,\"body\":{}}")
+            } else {
+                results = append(results, fmt.Sprintf("{\"code\":200,\"body\":{\"id\":%s}}", id))
+            }
+        }
+        w.Write([]byte("[" + strings.Join(results, ",") + "]"))
+    })
+    defer server.Close()
+
+    ids := make([]int64, 25)
+    for i := range ids {
+        ids[i] = int64(i + 1)
+    }
+
+    users, err := client.Users().GetMany(ids)
+
+    if err != nil || calls != 2 || len(users) != 24 || users[23].Id != 25 {
+        log.Printf("unexpected users. calls:%d users:%d err:%v\n", calls, len(users), err)
+        t.FailNow()
+    }
+}
+
+func Test_nickname_is_resolved_to_the_user_id(t *testing.T) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        if r.URL.Path == "/sites/MLA/search" && r.URL.Query().Get("nickname") == "foobar" {
+            w.Write([]byte("{\"seller\":{\"id\":123456,\"nickname\":\"foobar\"},\"results\":[]}"))
+            return
+        }
+        w.Write([]byte("{\"results\":[]}"))
+    })
+    defer server.Close()
+
+    if id, err := client.Users().IdByNickname("MLA", "foobar"); err != nil || id != 123456 {
+        log.Printf("unexpected id %d %v\n", id, err)
+        t.FailNow()
+    }
+
+    if _, err := client.Users().IdByNickname("MLA", "nobody"); err == nil {
+        log.Printf("an unknown nickname should fail\n")
+        t.FailNow()
+    }
+}
@@ -0,0 +1,262 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "net/http"
+    "unicode/utf8"
+)
+
+const (
+    DEFAULT_MAX_TITLE_LENGTH = 60
+    DEFAULT_MAX_PICTURES     = 12
+
+    VALIDATION_LOCAL = "local"
+    VALIDATION_API   = "api"
+)
+
+/*
+ValidationProblem is one of the problems found by ValidateItem. Field is the path of the field with the problem,
+for instance item.title or item.attributes[BRAND]. Source tells whether it was found locally or by /items/validate.
+*/
+type ValidationProblem struct {
+    Field   string
+    Code    string
+    Message string
+    Source  string
+    Warning bool
+}
+
+func (p ValidationProblem) String() string {
+    return fmt.Sprintf("%s: %s (%s)", p.Field, p.Message, p.Code)
+}
+
+//Tells whether any of the problems is an error. Warnings do not avoid listing the item.
+func HasErrors(problems []ValidationProblem) bool {
+
+    for _, problem := range problems {
+        if !problem.Warning {
+            return true
+        }
+    }
+
+    return false
+}
+
+type validationCause struct {
+    CauseId    int      `json:"cause_id"`
+    Type       string   `json:"type"`
+    Code       string   `json:"code"`
+    References []string `json:"references"`
+    Message    string   `json:"message"`
+}
+
+/*
+This method checks the item before listing it. First it runs the local checks: required attributes and allowed values
+of the category, price and currency of the site, amount of pictures, title length and attribute combinations of the
+variations. Then it calls /items/validate.
+An error is returned only when the checks could not be run; an empty list means the item is valid.
+*/
+func (client *Client) ValidateItem(item *Item) ([]ValidationProblem, error) {
+
+    problems := client.validateLocally(item)
+
+    apiProblems, err := client.validateWithApi(item)
+
+    if err != nil {
+        return problems, err
+    }
+
+    return append(problems, apiProblems...), nil
+}
+
+func (client *Client) validateLocally(item *Item) []ValidationProblem {
+
+    var problems []ValidationProblem
+
+    add := func(field string, code string, format string, args ...interface{}) {
+        problems = append(problems, ValidationProblem{Field: field, Code: code, Message: fmt.Sprintf(format, args...), Source: VALIDATION_LOCAL})
+    }
+
+    if item.CategoryId == "" {
+        add("item.category_id", "item.category_id.missing", "The category is missing.")
+        return problems
+    }
+
+    category, err := client.Categories().Get(item.CategoryId)
+
+    if err != nil {
+        add("item.category_id", "item.category_id.invalid", "The category %s could not be found: %s", item.CategoryId, err)
+        return problems
+    }
+
+    if !category.IsLeaf() {
+        add("item.category_id", "item.category_id.not_leaf", "Items may only be listed in leaf categories and %s has children.", item.CategoryId)
+    }
+
+    settings := category.Settings
+
+    maxTitle := settings.MaxTitleLength
+    if maxTitle == 0 {
+        maxTitle = DEFAULT_MAX_TITLE_LENGTH
+    }
+
+    if item.Title == "" {
+        add("item.title", "item.title.missing", "The title is missing.")
+    } else if length := utf8.RuneCountInString(item.Title); length > maxTitle {
+        add("item.title", "item.title.length", "The title has %d characters but the maximum is %d.", length, maxTitle)
+    }
+
+    if item.Price <= 0 {
+        add("item.price", "item.price.invalid", "The price must be greater than 0.")
+    } else if settings.MinimumPrice > 0 && item.Price < settings.MinimumPrice {
+        add("item.price", "item.price.minimum", "The minimum price of the category is %v.", settings.MinimumPrice)
+    } else if settings.MaximumPrice > 0 && item.Price > settings.MaximumPrice {
+        add("item.price", "item.price.maximum", "The maximum price of the category is %v.", settings.MaximumPrice)
+    }
+
+    siteId := item.SiteId
+    if siteId == "" {
+        siteId = siteOf(item.CategoryId)
+    }
+
+    if item.CurrencyId == "" {
+        add("item.currency_id", "item.currency_id.missing", "The currency is missing.")
+    } else if site, ok := GetSite(siteId); ok && !site.AcceptsCurrency(item.CurrencyId) {
+        add("item.currency_id", "item.currency_id.invalid", "The currency %s is not accepted by site %s.", item.CurrencyId, siteId)
+    } else if len(settings.Currencies) > 0 && !contains(settings.Currencies, item.CurrencyId) {
+        add("item.currency_id", "item.currency_id.invalid", "The currency %s is not accepted by the category.", item.CurrencyId)
+    }
+
+    maxPictures := settings.MaxPicturesPerItem
+    if maxPictures == 0 {
+        maxPictures = DEFAULT_MAX_PICTURES
+    }
+
+    if len(item.Pictures) == 0 {
+        add("item.pictures", "item.pictures.missing", "At least one picture is needed.")
+    } else if len(item.Pictures) > maxPictures {
+        add("item.pictures", "item.pictures.max", "The item has %d pictures but the maximum is %d.", len(item.Pictures), maxPictures)
+    }
+
+    attributes, err := client.Categories().Attributes(item.CategoryId)
+
+    if err != nil {
+        add("item.attributes", "item.attributes.unavailable", "The attributes of the category could not be read: %s", err)
+        return problems
+    }
+
+    for _, attribute := range attributes {
+        field := "item.attributes[" + attribute.Id + "]"
+        value := item.Attribute(attribute.Id)
+
+        if value == nil {
+            if attribute.IsRequired() {
+                add(field, "item.attributes.missing_required", "The attribute %s is required by the category.", attribute.Id)
+            }
+            continue
+        }
+
+        if value.ValueId != "" && !attribute.Allows(value.ValueId) {
+            add(field, "item.attributes.invalid_value", "The value %s is not allowed for %s.", value.ValueId, attribute.Id)
+        } else if value.ValueId == "" && value.ValueName == "" && attribute.IsRequired() {
+            add(field, "item.attributes.missing_required", "The attribute %s is required by the category.", attribute.Id)
+        }
+    }
+
+    if len(item.Variations) > 0 {
+        variationProblems, err := client.Variations().Validate(item.CategoryId, item.Variations)
+
+        if err != nil {
+            add("item.variations", "item.variations.unavailable", "The variations could not be checked: %s", err)
+        }
+        problems = append(problems, variationProblems...)
+    }
+
+    return problems
+}
+
+func (client *Client) validateWithApi(item *Item) ([]ValidationProblem, error) {
+
+    resp, err := client.NewRequest(http.MethodPost, "/items/validate").JSON(item).Do()
+
+    if err != nil {
+        return nil, err
+    }
+
+    if resp.StatusCode < http.StatusBadRequest {
+        resp.Body.Close()
+        return nil, nil
+    }
+
+    if resp.StatusCode != http.StatusBadRequest {
+        return nil, decodeResponse(resp, nil)
+    }
+
+    body, err := ioutil.ReadAll(resp.Body)
+    resp.Body.Close()
+
+    if err != nil {
+        return nil, err
+    }
+
+    var validation struct {
+        Message string            `json:"message"`
+        Cause   []validationCause `json:"cause"`
+    }
+
+    if err := json.Unmarshal(body, &validation); err != nil {
+        return nil, err
+    }
+
+    if len(validation.Cause) == 0 {
+        return []ValidationProblem{{Field: "item", Code: "item.invalid", Message: validation.Message, Source: VALIDATION_API}}, nil
+    }
+
+    problems := make([]ValidationProblem, 0, len(validation.Cause))
+
+    for _, cause := range validation.Cause {
+        field := "item"
+        if len(cause.References) > 0 {
+            field = cause.References[0]
+        }
+
+        problems = append(problems, ValidationProblem{
+            Field:   field,
+            Code:    cause.Code,
+            Message: cause.Message,
+            Source:  VALIDATION_API,
+            Warning: cause.Type == "warning",
+        })
+    }
+
+    return problems, nil
+}
+
+func contains(values []string, value string) bool {
+
+    for _, v := range values {
+        if v == value {
+            return true
+        }
+    }
+
+    return false
+}
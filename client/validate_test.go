@@ -0,0 +1,112 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "strings"
+)
+
+func newTestValidationClient() (*Client, func()) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+        case "/categories/MLA1912":
+            w.Write([]byte("{\"id\":\"MLA1912\",\"settings\":{\"max_title_length\":20,\"max_pictures_per_item\":2,\"currencies\":[\"ARS\"]}}"))
+        case "/categories/MLA1912/attributes":
+            w.Write([]byte("[{\"id\":\"BRAND\",\"tags\":{\"required\":true},\"values\":[{\"id\":\"1\",\"name\":\"Ray-Ban\"}]},{\"id\":\"MODEL\",\"tags\":{\"required\":true}}]"))
+        case "/items/validate":
+            w.WriteHeader(http.StatusBadRequest)
+            w.Write([]byte("{\"message\":\"Validation error\",\"error\":\"validation_error\",\"status\":400,\"cause\":[" +
+                "{\"type\":\"warning\",\"code\":\"item.listing_type_id.unavailable\",\"references\":[\"item.listing_type_id\"],\"message\":\"not available\"}]}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+
+    CategoriesCache.Clear()
+    return client, server.Close
+}
+
+func fields(problems []ValidationProblem) string {
+
+    var all []string
+
+    for _, problem := range problems {
+        all = append(all, problem.Field)
+    }
+
+    return strings.Join(all, ",")
+}
+
+func Test_validate_item_reports_local_and_api_problems_with_their_fields(t *testing.T) {
+
+    client, closeServer := newTestValidationClient()
+    defer closeServer()
+
+    item := &Item{
+        Title:      "Anteojos Ray-Ban Wayfarer Originales",
+        CategoryId: "MLA1912",
+        Price:      MoneyFromInt(10),
+        CurrencyId: "BRL",
+        Pictures:   []ItemPicture{{Source: "a"}, {Source: "b"}, {Source: "c"}},
+        Attributes: []ItemAttribute{{Id: "BRAND", ValueId: "2"}},
+    }
+
+    problems, err := client.ValidateItem(item)
+
+    if err != nil {
+        log.Printf("Error: %s\n", err)
+        t.FailNow()
+    }
+
+    expected := "item.title,item.currency_id,item.pictures,item.attributes[BRAND],item.attributes[MODEL],item.listing_type_id"
+
+    if fields(problems) != expected {
+        log.Printf("unexpected problems\n expected: %s\n obtained: %s\n", expected, fields(problems))
+        t.FailNow()
+    }
+
+    if !problems[len(problems)-1].Warning || problems[len(problems)-1].Source != VALIDATION_API || !HasErrors(problems) {
+        log.Printf("the problem returned by the API should be a warning %v\n", problems[len(problems)-1])
+        t.FailNow()
+    }
+}
+
+func Test_validate_item_accepts_a_valid_item(t *testing.T) {
+
+    client, closeServer := newTestValidationClient()
+    defer closeServer()
+
+    item := &Item{
+        Title:      "Ray-Ban Wayfarer",
+        CategoryId: "MLA1912",
+        Price:      MoneyFromInt(10),
+        CurrencyId: "ARS",
+        Pictures:   []ItemPicture{{Source: "a"}},
+        Attributes: []ItemAttribute{{Id: "BRAND", ValueId: "1"}, {Id: "MODEL", ValueName: "RB2140"}},
+    }
+
+    problems, _ := client.ValidateItem(item)
+
+    if HasErrors(problems) {
+        log.Printf("unexpected problems %v\n", problems)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,298 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+/*
+Variation is one of the combinations (for instance size and color) in which an item is sold.
+*/
+type Variation struct {
+    Id                    int64           `json:"id,omitempty"`
+    Price                 Money           `json:"price,omitempty"`
+    AvailableQuantity     int             `json:"available_quantity,omitempty"`
+    SoldQuantity          int             `json:"sold_quantity,omitempty"`
+    AttributeCombinations []ItemAttribute `json:"attribute_combinations,omitempty"`
+    Attributes            []ItemAttribute `json:"attributes,omitempty"`
+    PictureIds            []string        `json:"picture_ids,omitempty"`
+    SellerCustomField     string          `json:"seller_custom_field,omitempty"`
+    InventoryId           string          `json:"inventory_id,omitempty"`
+}
+
+/*
+Two variations with the same key have the same attribute combination.
+*/
+func (v *Variation) combinationKey() string {
+
+    values := make([]string, 0, len(v.AttributeCombinations))
+
+    for _, attribute := range v.AttributeCombinations {
+        value := attribute.ValueId
+        if value == "" {
+            value = strings.ToLower(strings.TrimSpace(attribute.ValueName))
+        }
+        values = append(values, attribute.Id+"="+value)
+    }
+
+    sort.Strings(values)
+    return strings.Join(values, "|")
+}
+
+/*
+Variations gives typed access to the variations of the items.
+*/
+type Variations struct {
+    client *Client
+}
+
+func (client *Client) Variations() *Variations {
+    return &Variations{client: client}
+}
+
+func variationPath(itemId string, variationId int64) string {
+    return "/items/" + itemId + "/variations/" + strconv.FormatInt(variationId, 10)
+}
+
+func (v *Variations) List(itemId string) ([]Variation, error) {
+
+    var variations []Variation
+    err := v.client.NewRequest(http.MethodGet, "/items/"+itemId+"/variations").Decode(&variations)
+
+    return variations, err
+}
+
+func (v *Variations) Get(itemId string, variationId int64) (*Variation, error) {
+
+    variation := new(Variation)
+
+    if err := v.client.NewRequest(http.MethodGet, variationPath(itemId, variationId)).Decode(variation); err != nil {
+        return nil, err
+    }
+
+    return variation, nil
+}
+
+/*
+This method adds a variation to the item. Its attribute combination is checked against the category and the
+variations the item already has before calling the API. The variations of the item are returned.
+*/
+func (v *Variations) Add(itemId string, variation *Variation) ([]Variation, error) {
+
+    item, err := v.client.Items().Get(itemId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    existing, err := v.List(itemId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    if err := v.check(item.CategoryId, append(existing, *variation)); err != nil {
+        return nil, err
+    }
+
+    var variations []Variation
+    err = v.client.NewRequest(http.MethodPost, "/items/"+itemId+"/variations").JSON(variation).Decode(&variations)
+
+    return variations, err
+}
+
+/*
+This method changes a variation. Only the fields set are sent; when the attribute combination changes, it is
+checked as in Add.
+*/
+func (v *Variations) Update(itemId string, variation *Variation) error {
+
+    if variation.Id == 0 {
+        return errors.New("The id of the variation to be updated is missing.")
+    }
+
+    if len(variation.AttributeCombinations) > 0 {
+
+        item, err := v.client.Items().Get(itemId)
+
+        if err != nil {
+            return err
+        }
+
+        existing, err := v.List(itemId)
+
+        if err != nil {
+            return err
+        }
+
+        for i := range existing {
+            if existing[i].Id == variation.Id {
+                existing[i] = *variation
+            }
+        }
+
+        if err := v.check(item.CategoryId, existing); err != nil {
+            return err
+        }
+    }
+
+    return v.client.NewRequest(http.MethodPut, variationPath(itemId, variation.Id)).JSON(variation).Decode(nil)
+}
+
+//Sets the stock of the variation. 0 is a valid stock, so it is sent explicitly.
+func (v *Variations) UpdateStock(itemId string, variationId int64, quantity int) error {
+
+    body := map[string]interface{}{"available_quantity": quantity}
+
+    return v.client.NewRequest(http.MethodPut, variationPath(itemId, variationId)).JSON(body).Decode(nil)
+}
+
+func (v *Variations) UpdatePrice(itemId string, variationId int64, price Money) error {
+
+    if price <= 0 {
+        return errors.New("The price must be greater than 0.")
+    }
+
+    body := map[string]interface{}{"price": price}
+
+    return v.client.NewRequest(http.MethodPut, variationPath(itemId, variationId)).JSON(body).Decode(nil)
+}
+
+/*
+This method sets the pictures of the variation. The pictures have to belong to the item, either uploaded through
+UploadPicture and linked with AddPictureToItem, or listed within the item.
+*/
+func (v *Variations) SetPictures(itemId string, variationId int64, pictureIds []string) error {
+
+    body := map[string]interface{}{"picture_ids": pictureIds}
+
+    return v.client.NewRequest(http.MethodPut, variationPath(itemId, variationId)).JSON(body).Decode(nil)
+}
+
+func (v *Variations) Delete(itemId string, variationId int64) error {
+    return v.client.NewRequest(http.MethodDelete, variationPath(itemId, variationId)).Decode(nil)
+}
+
+/*
+This method checks locally that every variation uses attributes of the category which allow variations, with allowed
+values, the same attributes in all of them, and that no attribute combination is repeated.
+*/
+func (v *Variations) Validate(categoryId string, variations []Variation) ([]ValidationProblem, error) {
+
+    attributes, err := v.client.Categories().Attributes(categoryId)
+
+    if err != nil {
+        return nil, err
+    }
+
+    byId := map[string]Attribute{}
+    for _, attribute := range attributes {
+        byId[attribute.Id] = attribute
+    }
+
+    var problems []ValidationProblem
+
+    add := func(field string, code string, format string, args ...interface{}) {
+        problems = append(problems, ValidationProblem{Field: field, Code: code, Message: fmt.Sprintf(format, args...), Source: VALIDATION_LOCAL})
+    }
+
+    seen := map[string]int{}
+    var firstIds string
+
+    for i, variation := range variations {
+        field := fmt.Sprintf("item.variations[%d]", i)
+
+        if len(variation.AttributeCombinations) == 0 {
+            add(field+".attribute_combinations", "variation.attribute_combinations.missing", "The variation has no attribute combination.")
+            continue
+        }
+
+        ids := make([]string, 0, len(variation.AttributeCombinations))
+
+        for _, combination := range variation.AttributeCombinations {
+            attributeField := field + ".attribute_combinations[" + combination.Id + "]"
+            attribute, ok := byId[combination.Id]
+            ids = append(ids, combination.Id)
+
+            if !ok || !(attribute.Tags.AllowVariations || attribute.Tags.VariationAttribute) {
+                add(attributeField, "variation.attribute_combinations.invalid", "The attribute %s does not allow variations in category %s.", combination.Id, categoryId)
+            } else if combination.ValueId != "" && !attribute.Allows(combination.ValueId) {
+                add(attributeField, "variation.attribute_combinations.invalid_value", "The value %s is not allowed for %s.", combination.ValueId, combination.Id)
+            } else if combination.ValueId == "" && combination.ValueName == "" {
+                add(attributeField, "variation.attribute_combinations.missing_value", "The attribute %s has no value.", combination.Id)
+            }
+        }
+
+        sort.Strings(ids)
+        joined := strings.Join(ids, ",")
+
+        if firstIds == "" {
+            firstIds = joined
+        } else if joined != firstIds {
+            add(field+".attribute_combinations", "variation.attribute_combinations.different", "Every variation must use the attributes %s.", firstIds)
+        }
+
+        key := variation.combinationKey()
+
+        if previous, repeated := seen[key]; repeated {
+            add(field+".attribute_combinations", "variation.attribute_combinations.duplicated", "The attribute combination is the same as the one of variation %d.", previous)
+        } else {
+            seen[key] = i
+        }
+    }
+
+    return problems, nil
+}
+
+func (v *Variations) check(categoryId string, variations []Variation) error {
+
+    problems, err := v.Validate(categoryId, variations)
+
+    if err != nil {
+        return err
+    }
+
+    if len(problems) > 0 {
+        return &VariationsError{Problems: problems}
+    }
+
+    return nil
+}
+
+/*
+VariationsError is returned by Add and Update when the local checks fail, so the API was not called.
+*/
+type VariationsError struct {
+    Problems []ValidationProblem
+}
+
+func (e *VariationsError) Error() string {
+
+    messages := make([]string, 0, len(e.Problems))
+
+    for _, problem := range e.Problems {
+        messages = append(messages, problem.String())
+    }
+
+    return "Invalid variations: " + strings.Join(messages, "; ")
+}
@@ -0,0 +1,106 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+    "testing"
+    "log"
+    "net/http"
+    "io/ioutil"
+)
+
+func newTestVariationsClient(posted *bool, put *string) (*Client, func()) {
+
+    client, server := newTestServerClient(func(w http.ResponseWriter, r *http.Request) {
+        switch {
+        case r.URL.Path == "/items/MLA123":
+            w.Write([]byte("{\"id\":\"MLA123\",\"category_id\":\"MLA1912\"}"))
+        case r.URL.Path == "/categories/MLA1912/attributes":
+            w.Write([]byte("[{\"id\":\"COLOR\",\"tags\":{\"allow_variations\":true}},{\"id\":\"SIZE\",\"tags\":{\"allow_variations\":true},\"values\":[{\"id\":\"S\"},{\"id\":\"M\"}]},{\"id\":\"BRAND\",\"tags\":{}}]"))
+        case r.Method == http.MethodGet && r.URL.Path == "/items/MLA123/variations":
+            w.Write([]byte("[{\"id\":1,\"attribute_combinations\":[{\"id\":\"COLOR\",\"value_name\":\"Negro\"},{\"id\":\"SIZE\",\"value_id\":\"S\"}]}]"))
+        case r.Method == http.MethodPost && r.URL.Path == "/items/MLA123/variations":
+            *posted = true
+            w.WriteHeader(http.StatusCreated)
+            w.Write([]byte("[]"))
+        case r.Method == http.MethodPut && r.URL.Path == "/items/MLA123/variations/1":
+            body, _ := ioutil.ReadAll(r.Body)
+            *put = string(body)
+            w.Write([]byte("{}"))
+        default:
+            w.WriteHeader(http.StatusNotFound)
+        }
+    })
+
+    CategoriesCache.Clear()
+    return client, server.Close
+}
+
+func Test_adding_a_repeated_combination_fails_before_calling_the_API(t *testing.T) {
+
+    posted := false
+    client, closeServer := newTestVariationsClient(&posted, new(string))
+    defer closeServer()
+
+    variation := &Variation{AvailableQuantity:5, AttributeCombinations:[]ItemAttribute{{Id:"SIZE", ValueId:"S"}, {Id:"COLOR", ValueName:"negro "}}}
+
+    _, err := client.Variations().Add("MLA123", variation)
+
+    if _, ok := err.(*VariationsError); !ok || posted {
+        log.Printf("the repeated combination should have been found locally %v\n", err)
+        t.FailNow()
+    }
+
+    variation.AttributeCombinations[1].ValueName = "Rojo"
+
+    if _, err := client.Variations().Add("MLA123", variation); err != nil || !posted {
+        log.Printf("a new combination should have been added %v\n", err)
+        t.FailNow()
+    }
+}
+
+func Test_variations_are_checked_against_the_category(t *testing.T) {
+
+    client, closeServer := newTestVariationsClient(new(bool), new(string))
+    defer closeServer()
+
+    variations := []Variation{
+        {AttributeCombinations:[]ItemAttribute{{Id:"BRAND", ValueName:"Ray-Ban"}}},
+        {AttributeCombinations:[]ItemAttribute{{Id:"SIZE", ValueId:"XL"}}},
+    }
+
+    problems, err := client.Variations().Validate("MLA1912", variations)
+
+    expected := "item.variations[0].attribute_combinations[BRAND],item.variations[1].attribute_combinations[SIZE],item.variations[1].attribute_combinations"
+
+    if err != nil || fields(problems) != expected {
+        log.Printf("unexpected problems\n expected: %s\n obtained: %s\n", expected, fields(problems))
+        t.FailNow()
+    }
+}
+
+func Test_stock_of_a_variation_may_be_set_to_zero(t *testing.T) {
+
+    put := ""
+    client, closeServer := newTestVariationsClient(new(bool), &put)
+    defer closeServer()
+
+    if err := client.Variations().UpdateStock("MLA123", 1, 0); err != nil || put != "{\"available_quantity\":0}" {
+        log.Printf("unexpected body %s %v\n", put, err)
+        t.FailNow()
+    }
+}
@@ -0,0 +1,130 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+    sdk "github.com/elagiglia/mercadolibre/client"
+    "errors"
+    "fmt"
+    "net/url"
+    "os"
+    "os/exec"
+    "runtime"
+    "time"
+)
+
+const LOGIN_TIMEOUT = 5 * time.Minute
+
+func (a *app) auth(args []string) error {
+
+    if len(args) != 1 {
+        return errors.New("usage: meli auth login | meli auth refresh")
+    }
+
+    switch args[0] {
+    case "login":
+        return a.login()
+    case "refresh":
+        return a.refresh()
+    }
+
+    return fmt.Errorf("unknown auth command %s", args[0])
+}
+
+/*
+The redirect URL of the profile has to point to localhost, so that the code sent by ML is caught by the SDK.
+That same URL has to be configured in the application.
+*/
+func (a *app) login() error {
+
+    if a.settings.ClientId == 0 || a.settings.Secret == "" {
+        return fmt.Errorf("client_id and client_secret must be set in profile %s or through MELI_CLIENT_ID and MELI_CLIENT_SECRET", a.profileName)
+    }
+
+    site, ok := sdk.GetSite(a.settings.Site)
+
+    if !ok {
+        return fmt.Errorf("unknown site %s", a.settings.Site)
+    }
+
+    redirect, err := url.Parse(a.settings.RedirectUrl)
+
+    if err != nil {
+        return err
+    }
+
+    options := sdk.LoopbackOptions{
+        Addr:    redirect.Host,
+        Path:    redirect.Path,
+        Timeout: LOGIN_TIMEOUT,
+        Open: func(authURL string) error {
+            fmt.Fprintf(os.Stderr, "Opening %s\n", authURL)
+            return openBrowser(authURL)
+        },
+        ApiUrl: os.Getenv("MELI_API_URL"),
+    }
+
+    client, err := sdk.NewLoopbackClient(a.settings.ClientId, a.settings.Secret, site.AuthURL, options)
+
+    if err != nil {
+        return err
+    }
+
+    if err := a.saveAuth(client); err != nil {
+        return err
+    }
+
+    fmt.Fprintf(os.Stderr, "Logged in. Token stored in profile %s.\n", a.profileName)
+    return nil
+}
+
+func (a *app) refresh() error {
+
+    client, err := a.client(false)
+
+    if err != nil {
+        return err
+    }
+
+    if err := client.RefreshToken(); err != nil {
+        return err
+    }
+
+    if err := a.saveAuth(client); err != nil {
+        return err
+    }
+
+    fmt.Fprintf(os.Stderr, "Token refreshed for profile %s.\n", a.profileName)
+    return nil
+}
+
+//If the browser can not be opened, the URL printed before can still be copied.
+func openBrowser(url string) error {
+
+    var cmd *exec.Cmd
+
+    switch runtime.GOOS {
+    case "darwin":
+        cmd = exec.Command("open", url)
+    case "windows":
+        cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+    default:
+        cmd = exec.Command("xdg-open", url)
+    }
+
+    return cmd.Start()
+}
@@ -0,0 +1,227 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+    sdk "github.com/elagiglia/mercadolibre/client"
+    "bytes"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "io/ioutil"
+    "net/http"
+    "net/url"
+    "os"
+    "strconv"
+    "strings"
+)
+
+//get, post, put and delete
+func (a *app) call(command string, args []string, maxArgs int) error {
+
+    if len(args) < 1 || len(args) > maxArgs {
+        return fmt.Errorf("usage: meli %s <path>", command)
+    }
+
+    client, err := a.client(command == "get")
+
+    if err != nil {
+        return err
+    }
+
+    request := client.NewRequest(strings.ToUpper(command), args[0])
+
+    if maxArgs == 2 {
+        source := "-"
+        if len(args) == 2 {
+            source = args[1]
+        }
+
+        body, err := readBody(source)
+
+        if err != nil {
+            return err
+        }
+        request.Body(bytes.NewReader(body))
+    }
+
+    return a.send(client, request)
+}
+
+func (a *app) items(args []string) error {
+
+    if len(args) < 1 {
+        return errors.New("usage: meli items list|create|update")
+    }
+
+    client, err := a.client(false)
+
+    if err != nil {
+        return err
+    }
+
+    switch args[0] {
+    case "list":
+        userId, err := a.userId(client)
+
+        if err != nil {
+            return err
+        }
+
+        path := "/users/" + strconv.FormatInt(userId, 10) + "/items/search"
+        return a.send(client, client.NewRequest(http.MethodGet, path).Params(keyValues(args[1:])))
+
+    case "create":
+        if len(args) != 2 {
+            return errors.New("usage: meli items create <file|->")
+        }
+
+        body, err := readBody(args[1])
+
+        if err != nil {
+            return err
+        }
+        return a.send(client, client.NewRequest(http.MethodPost, "/items").Body(bytes.NewReader(body)))
+
+    case "update":
+        if len(args) != 3 {
+            return errors.New("usage: meli items update <id> <file|->")
+        }
+
+        body, err := readBody(args[2])
+
+        if err != nil {
+            return err
+        }
+        return a.send(client, client.NewRequest(http.MethodPut, "/items/"+args[1]).Body(bytes.NewReader(body)))
+    }
+
+    return fmt.Errorf("unknown items command %s", args[0])
+}
+
+func (a *app) orders(args []string) error {
+
+    if len(args) < 1 || args[0] != "search" {
+        return errors.New("usage: meli orders search [key=value...]")
+    }
+
+    client, err := a.client(false)
+
+    if err != nil {
+        return err
+    }
+
+    params := keyValues(args[1:])
+
+    if params.Get("seller") == "" && params.Get("buyer") == "" {
+        userId, err := a.userId(client)
+
+        if err != nil {
+            return err
+        }
+        params.Set("seller", strconv.FormatInt(userId, 10))
+    }
+
+    return a.send(client, client.NewRequest(http.MethodGet, "/orders/search").Params(params))
+}
+
+/*
+Sends the request, pretty-prints the response and stores the token when it was refreshed.
+*/
+func (a *app) send(client *sdk.Client, request *sdk.Request) error {
+
+    resp, err := request.Do()
+
+    if err != nil {
+        return err
+    }
+
+    if err := a.saveAuth(client); err != nil {
+        return err
+    }
+
+    return printResponse(os.Stdout, resp)
+}
+
+//The user id comes within the token. Old tokens may not have it, so it is asked to the API.
+func (a *app) userId(client *sdk.Client) (int64, error) {
+
+    if client.Auth.UserId != 0 {
+        return client.Auth.UserId, nil
+    }
+
+    user, err := client.Users().Me()
+
+    if err != nil {
+        return 0, err
+    }
+
+    return user.Id, nil
+}
+
+func printResponse(out io.Writer, resp *http.Response) error {
+
+    defer resp.Body.Close()
+
+    body, err := ioutil.ReadAll(resp.Body)
+
+    if err != nil {
+        return err
+    }
+
+    var pretty bytes.Buffer
+
+    if json.Indent(&pretty, body, "", "  ") == nil {
+        body = pretty.Bytes()
+    }
+
+    if len(body) > 0 {
+        fmt.Fprintf(out, "%s\n", body)
+    }
+
+    if resp.StatusCode >= http.StatusBadRequest {
+        return fmt.Errorf("the API returned %s", resp.Status)
+    }
+
+    return nil
+}
+
+//"-" stands for stdin
+func readBody(source string) ([]byte, error) {
+
+    if source == "-" {
+        return ioutil.ReadAll(os.Stdin)
+    }
+
+    return ioutil.ReadFile(source)
+}
+
+func keyValues(args []string) url.Values {
+
+    values := url.Values{}
+
+    for _, arg := range args {
+        pair := strings.SplitN(arg, "=", 2)
+
+        if len(pair) == 2 {
+            values.Add(pair[0], pair[1])
+        }
+    }
+
+    return values
+}
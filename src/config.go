@@ -0,0 +1,160 @@
+/*
+Copyright [2016] [mercadolibre.com]
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+    sdk "github.com/elagiglia/mercadolibre/client"
+    "encoding/json"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "strconv"
+    "time"
+)
+
+const (
+    DEFAULT_PROFILE      = "default"
+    DEFAULT_REDIRECT_URL = "http://localhost:8910/callback"
+    DEFAULT_SITE         = "MLA"
+)
+
+/*
+A profile keeps the credentials of one application and the token obtained for it.
+*/
+type profile struct {
+    ClientId    int64              `json:"client_id"`
+    Secret      string             `json:"client_secret"`
+    RedirectUrl string             `json:"redirect_url"`
+    Site        string             `json:"site"`
+    Auth        *sdk.Authorization `json:"auth,omitempty"`
+}
+
+/*
+The config file is stored by default in $HOME/.meli/config.json. MELI_CONFIG may point to another file.
+*/
+type config struct {
+    path     string
+    Profiles map[string]*profile `json:"profiles"`
+}
+
+func configPath() string {
+
+    if path := os.Getenv("MELI_CONFIG"); path != "" {
+        return path
+    }
+
+    home, err := os.UserHomeDir()
+
+    if err != nil {
+        home = "."
+    }
+
+    return filepath.Join(home, ".meli", "config.json")
+}
+
+func loadConfig(path string) (*config, error) {
+
+    conf := &config{path: path, Profiles: map[string]*profile{}}
+
+    content, err := ioutil.ReadFile(path)
+
+    if os.IsNotExist(err) {
+        return conf, nil
+    }
+
+    if err != nil {
+        return nil, err
+    }
+
+    if err := json.Unmarshal(content, conf); err != nil {
+        return nil, err
+    }
+
+    if conf.Profiles == nil {
+        conf.Profiles = map[string]*profile{}
+    }
+
+    return conf, nil
+}
+
+//The file keeps secrets and tokens, so only the owner may read it.
+func (conf *config) save() error {
+
+    content, err := json.MarshalIndent(conf, "", "  ")
+
+    if err != nil {
+        return err
+    }
+
+    if err := os.MkdirAll(filepath.Dir(conf.path), 0700); err != nil {
+        return err
+    }
+
+    return ioutil.WriteFile(conf.path, content, 0600)
+}
+
+//Returns the stored profile, creating an empty one when it does not exist.
+func (conf *config) profile(name string) *profile {
+
+    p, ok := conf.Profiles[name]
+
+    if !ok {
+        p = &profile{}
+        conf.Profiles[name] = p
+    }
+
+    return p
+}
+
+/*
+Returns the settings to be used for the given profile. Environment variables take precedence over the config file
+and they are never written back to it.
+*/
+func (p *profile) effective() profile {
+
+    settings := *p
+
+    if id, err := strconv.ParseInt(os.Getenv("MELI_CLIENT_ID"), 10, 64); err == nil {
+        settings.ClientId = id
+    }
+
+    if secret := os.Getenv("MELI_CLIENT_SECRET"); secret != "" {
+        settings.Secret = secret
+    }
+
+    if redirect := os.Getenv("MELI_REDIRECT_URL"); redirect != "" {
+        settings.RedirectUrl = redirect
+    }
+
+    if site := os.Getenv("MELI_SITE"); site != "" {
+        settings.Site = site
+    }
+
+    if token := os.Getenv("MELI_ACCESS_TOKEN"); token != "" {
+        settings.Auth = &sdk.Authorization{AccessToken: token, ExpiresIn: 10800, ReceivedAt: time.Now().Unix()}
+    }
+
+    if settings.RedirectUrl == "" {
+        settings.RedirectUrl = DEFAULT_REDIRECT_URL
+    }
+
+    if settings.Site == "" {
+        settings.Site = DEFAULT_SITE
+    }
+
+    return settings
+}
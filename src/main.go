@@ -12,112 +12,169 @@ distributed under the License is distributed on an "AS IS" BASIS,
 WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
+
+
+**
+
+meli is a command line tool on top of the SDK.
+
+    meli [-profile name] [-v] <command> [arguments]
+
+Commands:
+
+    auth login                       opens the browser for authorizing the application and stores the token
+    auth refresh                     refreshes the stored token
+    get <path>                       calls any API and pretty-prints the JSON response
+    delete <path>
+    post <path> [file|-]             the body is read from the file or from stdin
+    put <path> [file|-]
+    items list [key=value...]        lists the items of the logged user
+    items create <file|->
+    items update <id> <file|->
+    orders search [key=value...]     searches the orders sold by the logged user
+
+Credentials are read from the profile stored in $HOME/.meli/config.json (or MELI_CONFIG).
+MELI_CLIENT_ID, MELI_CLIENT_SECRET, MELI_REDIRECT_URL, MELI_SITE and MELI_ACCESS_TOKEN override them.
+MELI_API_URL allows pointing to another API, for instance the mock API used by the tests.
 */
 
 package main
 
 import (
-    "github.com/elagiglia/mercadolibre/client"
+    sdk "github.com/elagiglia/mercadolibre/client"
+    "flag"
     "fmt"
-    "log"
-    "encoding/json"
     "io/ioutil"
+    "log"
+    "os"
 )
 
-const (
-    CLIENT_CODE = "TG-57616af7e4b0d077ddfe47e2-214509008"
-    CLIENT_ID = 396051416295796
-    CLIENT_SECRET = "qM66avGpv5rcQxNWF4sno5oH7Cjph0I7"
-)
+/*
+app keeps everything a command needs: the config file, the selected profile and the settings to be used.
+*/
+type app struct {
+    conf        *config
+    profileName string
+    stored      *profile
+    settings    profile
+}
 
 func main() {
 
-    /*Example 1)
-      Getting the URL to call for authenticating purposes
-      Once you generate the URL and call it, you will be redirected to a ML login page where your credentials will be asked. Then, after
-      entering your credentials you will obtained a CODE which will be used to get all the authorization tokens.
-    */
-
-    url := sdk.GetAuthURL(CLIENT_ID, sdk.MLA, "https://www.example.com")
-    fmt.Printf("Example 1) \n\t Returning Authentication URL:%s\n", url)
+    flags := flag.NewFlagSet("meli", flag.ExitOnError)
+    profileName := flags.String("profile", envOr("MELI_PROFILE", DEFAULT_PROFILE), "profile to be used")
+    verbose := flags.Bool("v", false, "logs what the SDK does")
+    flags.Usage = usage
+    flags.Parse(os.Args[1:])
 
-    /*
-    Example 2)
-    Calling a private API example.
-    */
+    if !*verbose {
+        log.SetOutput(ioutil.Discard)
+    }
 
-    client, err := sdk.NewClient(CLIENT_ID, CLIENT_CODE, CLIENT_SECRET, "https://www.example.com")
+    args := flags.Args()
 
-    if err != nil {
-        log.Printf("Error: %s", err.Error())
-        return
+    if len(args) == 0 {
+        usage()
+        os.Exit(2)
     }
 
-    resp, err := client.Get("/users/me")
+    conf, err := loadConfig(configPath())
 
     if err != nil {
-        log.Printf("Error %s\n", err.Error())
+        fail(err)
     }
 
-    userInfo, _:= ioutil.ReadAll(resp.Body)
-    resp.Body.Close()
+    stored := conf.profile(*profileName)
+    a := &app{conf: conf, profileName: *profileName, stored: stored, settings: stored.effective()}
 
-    fmt.Printf("Example 2) \n \t Response of GET /users/me: %s\n", userInfo)
-
-    /*
-      Example 3)
-      This example shows you how to POST (publish) a new Item.
-     */
+    if err := a.run(args[0], args[1:]); err != nil {
+        fail(err)
+    }
+}
 
-    body :=    "{\"title\":\"Item de test - No Ofertar\",\"category_id\":\"MLA1912\",\"price\":10,\"currency_id\":\"ARS\",\"available_quantity\":1,\"buying_mode\":\"buy_it_now\",\"listing_type_id\":\"bronze\",\"condition\":\"new\",\"description\": \"Item:,  Ray-Ban WAYFARER Gloss Black RB2140 901  Model: RB2140. Size: 50mm. Name: WAYFARER. Color: Gloss Black. Includes Ray-Ban Carrying Case and Cleaning Cloth. New in Box\",\"video_id\": \"YOUTUBE_ID_HERE\",\"warranty\": \"12 months by Ray Ban\",\"pictures\":[{\"source\":\"http://upload.wikimedia.org/wikipedia/commons/f/fd/Ray_Ban_Original_Wayfarer.jpg\"},{\"source\":\"http://en.wikipedia.org/wiki/File:Teashades.gif\"}]}"
+func (a *app) run(command string, args []string) error {
+
+    switch command {
+    case "auth":
+        return a.auth(args)
+    case "get", "delete":
+        return a.call(command, args, 1)
+    case "post", "put":
+        return a.call(command, args, 2)
+    case "items":
+        return a.items(args)
+    case "orders":
+        return a.orders(args)
+    }
 
-    resp, err = client.Post("/items", body)
+    usage()
+    return fmt.Errorf("unknown command %s", command)
+}
 
-    if err != nil {
-        log.Printf("Error %s\n", err.Error())
-    }
+/*
+Returns a client for the selected profile. When there is no token, an anonymous client is returned
+only if anonymous is true, so that public APIs may be called without logging in.
+*/
+func (a *app) client(anonymous bool) (*sdk.Client, error) {
 
-    itemAsJs, _ := ioutil.ReadAll(resp.Body)
-    resp.Body.Close()
-    fmt.Printf("Example 3) \n\t Response of POST /items : %s\n", itemAsJs)
+    var client *sdk.Client
+    var err error
 
-    item := new(item)
-    err = json.Unmarshal(itemAsJs, item)
-    fmt.Printf("ItemId:%s\n", item.Id)
+    if a.settings.Auth != nil {
+        client, err = sdk.NewClientWithAuthorization(a.settings.ClientId, a.settings.Secret, a.settings.RedirectUrl, *a.settings.Auth)
+    } else if anonymous {
+        client, err = sdk.NewAnonymousClient()
+    } else {
+        return nil, fmt.Errorf("profile %s is not logged in. Run: meli -profile %s auth login", a.profileName, a.profileName)
+    }
 
-    /*
-      Example 4)
-      This example shows you how to PUT a change in an Item.
-     */
+    if err == nil && os.Getenv("MELI_API_URL") != "" {
+        client.ApiUrl = os.Getenv("MELI_API_URL")
+    }
 
-    change := "{\"available_quantity\": 6}"
+    return client, err
+}
 
-    resp, err = client.Put("/items/" + item.Id, &change)
+/*
+Stores the token of the client when it has changed (for instance, because it was refreshed).
+Tokens given through MELI_ACCESS_TOKEN are never stored.
+*/
+func (a *app) saveAuth(client *sdk.Client) error {
 
-    if err != nil {
-        log.Printf("Error %s\n", err.Error())
+    if client.Auth == sdk.ANONYMOUS || os.Getenv("MELI_ACCESS_TOKEN") != "" {
+        return nil
     }
-    userInfo, _= ioutil.ReadAll(resp.Body)
-    resp.Body.Close()
 
-    fmt.Printf("Example 4) \n\t Response of PUT /items : %s\n", userInfo)
+    if a.stored.Auth != nil && *a.stored.Auth == client.Auth {
+        return nil
+    }
 
-    /*
-     Example 5)
-     This example shows you how to DELETE an Item.
-    */
+    auth := client.Auth
+    a.stored.Auth = &auth
+    a.settings.Auth = &auth
 
-   /* resp, err = client.Delete("/items/" + item.Id)
+    return a.conf.save()
+}
 
-    if err != nil {
-        log.Printf("Error %s\n", err.Error())
+func envOr(key string, value string) string {
+    if env := os.Getenv(key); env != "" {
+        return env
     }
-    userInfo, _= ioutil.ReadAll(resp.Body)
-    resp.Body.Close()
+    return value
+}
+
+func usage() {
+    fmt.Fprintf(os.Stderr, `usage: meli [-profile name] [-v] <command> [arguments]
 
-    fmt.Printf("Example 5 \n\t Response of DELETE /items : %s\n", userInfo)*/
+    auth login | auth refresh
+    get <path> | delete <path>
+    post <path> [file|-] | put <path> [file|-]
+    items list [key=value...] | items create <file|-> | items update <id> <file|->
+    orders search [key=value...]
+`)
 }
 
-type item struct {
-    Id string
+func fail(err error) {
+    fmt.Fprintf(os.Stderr, "meli: %s\n", err)
+    os.Exit(1)
 }